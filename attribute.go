@@ -0,0 +1,105 @@
+package brush
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Attribute represents one or more (combined using "|") text decorations like bold or underline.
+// Be aware that not all terminal support every attribute
+type Attribute uint16
+
+// All the different text decorations, they can be combined: Bold | Underline
+const (
+	Bold Attribute = 1 << iota
+	Faint
+	Italic
+	Underline
+	Blink
+	Reverse
+	Conceal
+	Strikethrough
+)
+
+var attributes = [...]struct {
+	name      string
+	code, off int
+}{
+	{"bold", 1, 22},
+	{"faint", 2, 22},
+	{"italic", 3, 23},
+	{"underline", 4, 24},
+	{"blink", 5, 25},
+	{"reverse", 7, 27},
+	{"conceal", 8, 28},
+	{"strikethrough", 9, 29},
+}
+
+// Has tells if all the given attributes are set
+func (a Attribute) Has(attrs Attribute) bool {
+	return a&attrs == attrs
+}
+
+// String gives the names of the attributes separated by a space
+func (a Attribute) String() string {
+	var names []string
+	for i, attr := range attributes {
+		if a.Has(1 << i) {
+			names = append(names, attr.name)
+		}
+	}
+	return strings.Join(names, " ")
+}
+
+// ParseAttributes converts the names of some attributes separated by spaces or commas
+// (ex. "bold underline") into the corresponding Attribute
+func ParseAttributes(names string) (attrs Attribute, err error) {
+	for _, name := range strings.FieldsFunc(names, isSeparator) {
+		attr, ok := parseAttribute(name)
+		if !ok {
+			return 0, fmt.Errorf("Cannot parse %s attribute: Unknown name", name)
+		}
+		attrs |= attr
+	}
+
+	return
+}
+
+func parseAttribute(name string) (Attribute, bool) {
+	name = strings.ToLower(name)
+	for i, attr := range attributes {
+		if attr.name == name {
+			return 1 << i, true
+		}
+	}
+	return 0, false
+}
+
+func isSeparator(r rune) bool {
+	return r == ' ' || r == ','
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (a Attribute) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (a *Attribute) UnmarshalText(text []byte) (err error) {
+	*a, err = ParseAttributes(string(text))
+	return
+}
+
+func (a Attribute) codes() string {
+	var codes []byte
+	for i, attr := range attributes {
+		if a.Has(1 << i) {
+			if len(codes) > 0 {
+				codes = append(codes, ';')
+			}
+			codes = strconv.AppendInt(codes, int64(attr.code), 10)
+		}
+	}
+	return string(codes)
+}
@@ -0,0 +1,63 @@
+package brush_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleParseAttributes() {
+	attrs, err := brush.ParseAttributes("bold, underline")
+	fmt.Println(attrs.Has(brush.Bold|brush.Underline), attrs.Has(brush.Italic), err)
+
+	_, err = brush.ParseAttributes("bold shiny")
+	fmt.Println(err)
+
+	// Output:
+	// true false <nil>
+	// Cannot parse shiny attribute: Unknown name
+}
+
+/* ---[ TESTS ]--- */
+
+func TestAttribute_String(t *testing.T) {
+	assert(t, "No attributes", brush.Attribute(0).String(), "")
+	assert(t, "Bold", brush.Bold.String(), "bold")
+	assert(t, "Combined", (brush.Strikethrough | brush.Bold | brush.Reverse).String(), "bold reverse strikethrough")
+
+	all := brush.Bold | brush.Faint | brush.Italic | brush.Underline | brush.Blink | brush.Reverse | brush.Conceal | brush.Strikethrough
+	parsed, err := brush.ParseAttributes(all.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert(t, "Round trip", parsed, all)
+}
+
+func TestAttribute_Paint(t *testing.T) {
+	myBrush := brush.New(brush.Red, nil)
+	myBrush.Disable = false
+
+	assert(t, "Painting bold",
+		myBrush.UseAttributes(brush.Bold).Paint("bold").String(),
+		"\x1b[1;31mbold\x1b[0m",
+	)
+	assert(t, "Painting underline and italic",
+		myBrush.UseAttributes(brush.Underline|brush.Italic).UseBgColor(brush.White).Paint("fancy").String(),
+		"\x1b[3;4;31;47mfancy\x1b[0m",
+	)
+
+	color := brush.Color{}
+	noColor := brush.New(color, nil)
+	noColor.Disable = false
+	assert(t, "Painting only attributes",
+		noColor.UseAttributes(brush.Strikethrough).Paint("wrong").String(),
+		"\x1b[9mwrong\x1b[0m",
+	)
+	assert(t, "Painting nothing",
+		noColor.UseAttributes(0).Paint("plain").String(),
+		"plain",
+	)
+}
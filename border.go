@@ -0,0 +1,53 @@
+package brush
+
+import "strings"
+
+// Border represents the set of characters used to draw the lines around and between some content
+type Border struct {
+	Top, Bottom, Left, Right                   string
+	TopLeft, TopRight, BottomLeft, BottomRight string
+
+	// junctions used when lines are crossing (ex. between the cells of a table)
+	TopJoin, BottomJoin, LeftJoin, RightJoin, Cross string
+}
+
+// Built-in borders, use ASCIIBorder as a fallback for terminals that cannot show the others
+var (
+	SingleBorder = Border{
+		Top: "─", Bottom: "─", Left: "│", Right: "│",
+		TopLeft: "┌", TopRight: "┐", BottomLeft: "└", BottomRight: "┘",
+		TopJoin: "┬", BottomJoin: "┴", LeftJoin: "├", RightJoin: "┤", Cross: "┼",
+	}
+	DoubleBorder = Border{
+		Top: "═", Bottom: "═", Left: "║", Right: "║",
+		TopLeft: "╔", TopRight: "╗", BottomLeft: "╚", BottomRight: "╝",
+		TopJoin: "╦", BottomJoin: "╩", LeftJoin: "╠", RightJoin: "╣", Cross: "╬",
+	}
+	RoundedBorder = Border{
+		Top: "─", Bottom: "─", Left: "│", Right: "│",
+		TopLeft: "╭", TopRight: "╮", BottomLeft: "╰", BottomRight: "╯",
+		TopJoin: "┬", BottomJoin: "┴", LeftJoin: "├", RightJoin: "┤", Cross: "┼",
+	}
+	ThickBorder = Border{
+		Top: "━", Bottom: "━", Left: "┃", Right: "┃",
+		TopLeft: "┏", TopRight: "┓", BottomLeft: "┗", BottomRight: "┛",
+		TopJoin: "┳", BottomJoin: "┻", LeftJoin: "┣", RightJoin: "┫", Cross: "╋",
+	}
+	ASCIIBorder = Border{
+		Top: "-", Bottom: "-", Left: "|", Right: "|",
+		TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+",
+		TopJoin: "+", BottomJoin: "+", LeftJoin: "+", RightJoin: "+", Cross: "+",
+	}
+)
+
+// horizontal draws an horizontal line made of the given segments of the given widths
+func (b Border) horizontal(left, line, join, right string, widths []int) string {
+	res := left
+	for i, w := range widths {
+		if i > 0 {
+			res += join
+		}
+		res += strings.Repeat(line, w)
+	}
+	return res + right
+}
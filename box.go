@@ -0,0 +1,143 @@
+package brush
+
+import "strings"
+
+// Spacing represents the empty space around some content,
+// measured in lines for the top and bottom sides and in cells for the left and right ones
+type Spacing struct {
+	Top, Right, Bottom, Left int
+}
+
+// Space creates a Spacing similarly to the CSS shorthand:
+// Space(all), Space(vertical, horizontal), Space(top, horizontal, bottom) or Space(top, right, bottom, left)
+func Space(sizes ...int) (s Spacing) {
+	switch len(sizes) {
+	case 1:
+		s = Spacing{sizes[0], sizes[0], sizes[0], sizes[0]}
+	case 2:
+		s = Spacing{sizes[0], sizes[1], sizes[0], sizes[1]}
+	case 3:
+		s = Spacing{sizes[0], sizes[1], sizes[2], sizes[1]}
+	case 4:
+		s = Spacing{sizes[0], sizes[1], sizes[2], sizes[3]}
+	}
+	return
+}
+
+// Box frames some content, the content can span on multiple lines and each one of them will be
+// filled with spaces to occupy the whole width so that the background of the FillBrush covers all the box
+type Box struct {
+	Border      *Border   // Border drawn around the padding, nil means none
+	BorderBrush Painter   // BorderBrush is used to paint the border and it's layered below the title
+	FillBrush   Painter   // FillBrush is used to paint the content and the padding
+	Padding     Spacing   // Padding is the space between the content and the border
+	Margin      Spacing   // Margin is the (unpainted) space around the border
+	Align       Alignment // Align is the horizontal alignment of the content
+	Title       any       // Title is embedded in the top border, it's ignored if there is no border
+	TitleAlign  Alignment // TitleAlign is the horizontal alignment of the title
+	Width       int       // Width is the minimum width of the box, margin excluded
+}
+
+// Render gives back the given values (joined without separator) framed by the box
+func (b Box) Render(values ...any) Highlighted {
+	var (
+		lines = Join(values...).lines()
+		inner int
+		title Highlighted
+	)
+
+	for _, line := range lines {
+		inner = max(inner, textWidth(line.content))
+	}
+
+	var borders int
+	if b.Border != nil {
+		borders = 2
+		if b.Title != nil {
+			title = toHighlighted(b.Title).lines()[0].layer(layerOf(b.BorderBrush))
+			// title is surrounded by a space and at least a piece of border on each side
+			inner = max(inner, textWidth(title.content)+4-b.Padding.Left-b.Padding.Right)
+		}
+	}
+	inner = max(inner, b.Width-borders-b.Padding.Left-b.Padding.Right)
+
+	var (
+		parts  []any
+		fill   = layerOf(b.FillBrush)
+		filled = inner + b.Padding.Left + b.Padding.Right
+		margin = strings.Repeat(" ", b.Margin.Left)
+		empty  = Join(strings.Repeat(" ", filled)).layer(fill)
+	)
+
+	addLine := func(line any) {
+		parts = append(parts, margin, line, strings.Repeat(" ", b.Margin.Right), "\n")
+	}
+	addContent := func(line Highlighted) {
+		if b.Border == nil {
+			addLine(line)
+			return
+		}
+		addLine(Join(b.piece(b.Border.Left), line, b.piece(b.Border.Right)))
+	}
+
+	for i := 0; i < b.Margin.Top; i++ {
+		parts = append(parts, "\n")
+	}
+	if b.Border != nil {
+		addLine(b.topBorder(title, filled))
+	}
+	for i := 0; i < b.Padding.Top; i++ {
+		addContent(empty)
+	}
+	for _, line := range lines {
+		addContent(Join(
+			strings.Repeat(" ", b.Padding.Left),
+			line.pad(inner, b.Align),
+			strings.Repeat(" ", b.Padding.Right),
+		).layer(fill))
+	}
+	for i := 0; i < b.Padding.Bottom; i++ {
+		addContent(empty)
+	}
+	if b.Border != nil {
+		addLine(b.piece(b.Border.BottomLeft + strings.Repeat(b.Border.Bottom, filled) + b.Border.BottomRight))
+	}
+	for i := 0; i < b.Margin.Bottom; i++ {
+		parts = append(parts, "\n")
+	}
+
+	// remove last new line
+	res := Join(parts...)
+	return res.slice(0, len(res.content)-1)
+}
+
+func (b Box) topBorder(title Highlighted, width int) Highlighted {
+	if title.content == "" {
+		return Join(b.piece(b.Border.TopLeft + strings.Repeat(b.Border.Top, width) + b.Border.TopRight))
+	}
+
+	var (
+		missing     = width - textWidth(title.content) - 2
+		left, right = 1, missing - 1
+	)
+	switch b.TitleAlign {
+	case AlignRight:
+		left, right = missing-1, 1
+	case AlignCenter:
+		left = missing / 2
+		right = missing - left
+	}
+
+	return Join(
+		b.piece(b.Border.TopLeft+strings.Repeat(b.Border.Top, left)+" "),
+		title,
+		b.piece(" "+strings.Repeat(b.Border.Top, right)+b.Border.TopRight),
+	)
+}
+
+func (b Box) piece(s string) any {
+	if b.BorderBrush == nil {
+		return s
+	}
+	return b.BorderBrush.Paint(s)
+}
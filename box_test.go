@@ -0,0 +1,86 @@
+package brush_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleBox() {
+	box := brush.Box{
+		Border:  &brush.DoubleBorder,
+		Padding: brush.Space(0, 1),
+		Title:   "Warning",
+	}
+
+	fmt.Println(box.Render("Disk almost full\n(95% used)"))
+
+	// Output:
+	// ╔═ Warning ════════╗
+	// ║ Disk almost full ║
+	// ║ (95% used)       ║
+	// ╚══════════════════╝
+}
+
+func ExampleBox_FillBrush() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	box := brush.Box{
+		Border:      &brush.RoundedBorder,
+		BorderBrush: brush.New(brush.Yellow, nil),
+		FillBrush:   brush.New(brush.Black, brush.UseColor(brush.Yellow)),
+		Padding:     brush.Space(0, 1),
+	}
+
+	fmt.Println(box.Render("hi\n", brush.Paint(brush.Red, nil, "there")).Markup())
+
+	// Output:
+	// [yellow]╭───────╮[/]
+	// [yellow]│[/][black on yellow] hi    [/][yellow]│[/]
+	// [yellow]│[/][black on yellow] [/][red on yellow]there[/][black on yellow] [/][yellow]│[/]
+	// [yellow]╰───────╯[/]
+}
+
+/* ---[ TESTS ]--- */
+
+func TestBox_Render(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	assert(t, "No border", brush.Box{}.Render("a\nbcd").String(), "a  \nbcd")
+
+	box := brush.Box{
+		Border:     &brush.ASCIIBorder,
+		Margin:     brush.Space(1, 2),
+		Padding:    brush.Space(1, 0, 0),
+		Align:      brush.AlignCenter,
+		Title:      "long title",
+		TitleAlign: brush.AlignRight,
+	}
+	assert(t, "Margin, padding and title", box.Render("x").String(), strings.Join([]string{
+		"",
+		"  +- long title -+  ",
+		"  |              |  ",
+		"  |      x       |  ",
+		"  +--------------+  ",
+		"",
+	}, "\n"))
+
+	box = brush.Box{Border: &brush.ThickBorder, Width: 10, Title: "T", TitleAlign: brush.AlignCenter, Align: brush.AlignRight}
+	assert(t, "Fixed width", box.Render("ab").String(), strings.Join([]string{
+		"┏━━ T ━━━┓",
+		"┃      ab┃",
+		"┗━━━━━━━━┛",
+	}, "\n"))
+}
+
+func TestSpace(t *testing.T) {
+	assert(t, "Space()", brush.Space(), brush.Spacing{})
+	assert(t, "Space(1)", brush.Space(1), brush.Spacing{1, 1, 1, 1})
+	assert(t, "Space(1, 2)", brush.Space(1, 2), brush.Spacing{1, 2, 1, 2})
+	assert(t, "Space(1, 2, 3)", brush.Space(1, 2, 3), brush.Spacing{1, 2, 3, 2})
+	assert(t, "Space(1, 2, 3, 4)", brush.Space(1, 2, 3, 4), brush.Spacing{1, 2, 3, 4})
+}
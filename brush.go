@@ -1,12 +1,27 @@
 package brush
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
 // Brush lets you paint some strings and change styling more freely
 type Brush[color ColorType] struct {
 	Foreground, defForeground color
 	Background, defBackground Optional[color]
+	Attributes                Attribute
 	Disable                   bool
+	link, linkID              string
+	renderer                  *Renderer // renderer used to adapt the colors, the Default one if nil
+}
+
+// Painter is implemented by every Brush, whatever its ColorType,
+// it's used by the components that can be styled using different brushes
+type Painter interface {
+	Paint(values ...any) Painted
+	Embed(values ...any) Highlighted
+	styling() *style
+	settings() Renderer
 }
 
 // New creates a new Brush with the given default colors of a specified set.
@@ -15,7 +30,7 @@ func New[color ColorType](font color, background Optional[color]) Brush[color] {
 	var b = Brush[color]{
 		defForeground: font,
 		defBackground: background,
-		Disable:       Disable || DisableIfNotTTY && !isATTY,
+		Disable:       disabled(),
 	}
 	b.UseDefaultColor()
 
@@ -47,26 +62,61 @@ func (b *Brush[color]) Swap() *Brush[color] {
 	return b
 }
 
+// UseAttributes overrides the text decorations (ex. Bold | Underline) and gives back the same (now modified) brush
+func (b *Brush[color]) UseAttributes(attrs Attribute) *Brush[color] {
+	b.Attributes = attrs
+	return b
+}
+
+// UseLink makes everything painted by the brush a clickable link to the given url (see the Link method of Painted),
+// an empty url removes it. It gives back the same (now modified) brush
+func (b *Brush[color]) UseLink(url, id string) *Brush[color] {
+	b.link, b.linkID = safeLink(url, id)
+	return b
+}
+
 // UseDefaultColor overrides font and background color by using the default values and gives back the same (now modified) brush
 func (b *Brush[color]) UseDefaultColor() *Brush[color] {
 	b.Foreground, b.Background = b.defForeground, b.defBackground
 	return b
 }
 
-// Print shows on stdout some values (joined without separator)
-// enforcing the current font and background color of the brush
+func (b Brush[color]) styling() *style {
+	if b.Disable {
+		return nil
+	}
+	s := b.extract()
+	return &s
+}
+
+// settings gives the renderer that the brush follows
+func (b Brush[color]) settings() Renderer {
+	if b.renderer != nil {
+		return *b.renderer
+	}
+	return defaults()
+}
+
+// Print shows on the output of its renderer (stdout unless created using a Renderer) some values
+// (joined without separator) enforcing the current font and background color of the brush
 func (b Brush[color]) Print(values ...any) {
-	fmt.Print(b.Paint(values...))
+	fmt.Fprint(b.output(), b.Paint(values...))
 }
 
-// Println shows on stdout some values (joined with " ", and adding a "\n" at the end)
+// Println shows on the output of its renderer some values (joined with " ", and adding a "\n" at the end)
 // enforcing the current font and background color of the brush
 func (b Brush[color]) Println(values ...any) {
-	fmt.Print(b.Paintln(values...))
+	fmt.Fprint(b.output(), b.Paintln(values...))
 }
 
-// Printf shows on stdout some values by replacing the placeholders in the given model with the corresponding values
-// enforcing the current font and background color of the brush
+// Printf shows on the output of its renderer some values by replacing the placeholders in the given model
+// with the corresponding values enforcing the current font and background color of the brush
 func (b Brush[color]) Printf(model string, values ...any) {
-	fmt.Print(b.Paintf(model, values...))
+	fmt.Fprint(b.output(), b.Paintf(model, values...))
+}
+
+// output gives the writer used by the Print methods
+func (b Brush[color]) output() io.Writer {
+	r := b.settings()
+	return r.output()
 }
@@ -1,6 +1,19 @@
 package brush_test
 
-import "github.com/DazFather/brush"
+import (
+	"os"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+// TestMain makes the outcome of the tests independent of the NO_COLOR environment variable,
+// the tests that expect some styling only need to set DisableIfNotTTY
+func TestMain(m *testing.M) {
+	os.Unsetenv("NO_COLOR")
+	brush.Disable = false
+	os.Exit(m.Run())
+}
 
 func ExampleBrush_UseFontColor() {
 	brush.DisableIfNotTTY = false
@@ -75,3 +88,15 @@ func ExampleBrush_Printf() {
 	myBrush.Printf("%s %s", "Hello", "World")
 	// Output: [30;47mHello World[0m
 }
+
+func ExampleBrush_UseAttributes() {
+	brush.DisableIfNotTTY = false
+	myBrush := brush.New(brush.Red, nil)
+
+	myBrush.Println("something")
+	myBrush.UseAttributes(brush.Bold | brush.Underline).Println("something important")
+	// Output:
+	// [31msomething
+	// [0m[1;4;31msomething important
+	// [0m
+}
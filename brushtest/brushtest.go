@@ -0,0 +1,202 @@
+// Package brushtest provides helpers to test styled output produced with the brush package:
+// assertions on the plain text and on the style of some parts, golden files and readable diffs.
+//
+// Values are compared using their markup (the language parsed by brush.Markup), so that failures show
+// "[bold red]error[/]" instead of raw escape sequences
+package brushtest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+var update = flag.Bool("brushtest.update", false, "update the golden files instead of comparing them")
+
+// ForceColor enables (or disables) the styling of the Default renderer for the duration of the test,
+// ignoring if the output is a terminal. The Default renderer is shared by all the tests of the package,
+// so parallel tests that paint should use their own brush.Renderer instead
+func ForceColor(t testing.TB, enabled bool) {
+	t.Helper()
+
+	r := brush.Default()
+	r.Disable = !enabled
+	previous := brush.SetDefault(r)
+	t.Cleanup(func() {
+		brush.SetDefault(previous)
+	})
+}
+
+// Markup gives the value (Painted, Highlighted or anything else) written in the markup language of brush,
+// it's the representation used to compare values and to write golden files
+func Markup(value any) string {
+	return highlighted(value).Markup()
+}
+
+// highlighted converts the value to an Highlighted item keeping its styling
+func highlighted(value any) brush.Highlighted {
+	switch v := value.(type) {
+	case *brush.Painted:
+		return brush.Join(*v)
+	case *brush.Highlighted:
+		return *v
+	}
+	return brush.Join(value)
+}
+
+// checkEnabled fails if the styling of the value or of the Default renderer is disabled,
+// because it would look the same whatever its style is. The values joined while the Default renderer
+// was disabled do not keep track of it, so it's required to enable it using ForceColor
+func checkEnabled(t testing.TB, value any) bool {
+	t.Helper()
+
+	if brush.Default().Disable {
+		t.Errorf("the styling of the Default renderer is disabled, it can be enabled using ForceColor")
+		return false
+	}
+
+	var disabled bool
+	switch v := value.(type) {
+	case brush.Painted:
+		disabled = v.Disabled()
+	case *brush.Painted:
+		disabled = v.Disabled()
+	case brush.Highlighted:
+		disabled = v.Disabled()
+	case *brush.Highlighted:
+		disabled = v.Disabled()
+	}
+
+	if disabled {
+		t.Errorf("the styling of %q is disabled, it can be enabled using ForceColor", brush.Plain(value))
+		return false
+	}
+	return true
+}
+
+// AssertPlain checks that the content of the value without any styling is the expected one
+func AssertPlain(t testing.TB, value any, expected string) bool {
+	t.Helper()
+
+	if got := brush.Plain(value); got != expected {
+		t.Errorf("unexpected plain text:\n%s", Diff(expected, got))
+		return false
+	}
+	return true
+}
+
+// AssertEqual checks that the two values have the same content and styling,
+// it fails if the styling of one of them or of the Default renderer is disabled (see ForceColor)
+func AssertEqual(t testing.TB, got, expected any) bool {
+	t.Helper()
+
+	if !checkEnabled(t, got) || !checkEnabled(t, expected) {
+		return false
+	}
+	if g, e := Markup(got), Markup(expected); g != e {
+		t.Errorf("unexpected styled text:\n%s", Diff(e, g))
+		return false
+	}
+	return true
+}
+
+// AssertStyle checks that the first occurrence of substring inside the value is painted with the expected style,
+// it fails if the styling of the value or of the Default renderer is disabled (see ForceColor)
+func AssertStyle(t testing.TB, value any, substring string, expected brush.Style) bool {
+	t.Helper()
+
+	if !checkEnabled(t, value) {
+		return false
+	}
+	var (
+		h    = highlighted(value)
+		from = strings.Index(brush.Plain(h), substring)
+	)
+	if from < 0 {
+		t.Errorf("%q not found in %q", substring, brush.Plain(h))
+		return false
+	}
+
+	for offset := from; offset < from+len(substring); offset++ {
+		if got := h.StyleAt(offset); got != expected {
+			t.Errorf("%q has style %s instead of %s at byte %d, in:\n%s",
+				substring, styleName(got), styleName(expected), offset-from, Markup(h))
+			return false
+		}
+	}
+	return true
+}
+
+func styleName(s brush.Style) string {
+	if s.IsZero() {
+		return "[none]"
+	}
+	return s.String()
+}
+
+// AssertGolden compares the markup of the value with the content of the file testdata/<name>.golden,
+// if the test is run with the -brushtest.update flag the file is written instead.
+// It fails if the styling of the value or of the Default renderer is disabled (see ForceColor)
+func AssertGolden(t testing.TB, value any, name string) bool {
+	t.Helper()
+
+	if !checkEnabled(t, value) {
+		return false
+	}
+
+	var (
+		path = filepath.Join("testdata", name+".golden")
+		got  = Markup(value)
+	)
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("cannot read golden file (run with -brushtest.update to create it): %s", err)
+		return false
+	}
+	if string(expected) != got {
+		t.Errorf("output differs from %s:\n%s", path, Diff(string(expected), got))
+		return false
+	}
+	return true
+}
+
+// Diff gives a line by line comparison of the two strings, marking with "-" the expected lines
+// and with "+" the ones got instead. Lines are quoted so that special characters are visible
+func Diff(expected, got string) string {
+	var (
+		res       strings.Builder
+		wantLines = strings.Split(expected, "\n")
+		gotLines  = strings.Split(got, "\n")
+	)
+
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		switch {
+		case i >= len(gotLines):
+			fmt.Fprintf(&res, "- %q\n", wantLines[i])
+		case i >= len(wantLines):
+			fmt.Fprintf(&res, "+ %q\n", gotLines[i])
+		case wantLines[i] == gotLines[i]:
+			fmt.Fprintf(&res, "  %q\n", wantLines[i])
+		default:
+			fmt.Fprintf(&res, "- %q\n+ %q\n", wantLines[i], gotLines[i])
+		}
+	}
+
+	return res.String()
+}
@@ -0,0 +1,146 @@
+package brushtest_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+	"github.com/DazFather/brush/brushtest"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleDiff() {
+	fmt.Print(brushtest.Diff("[red]error[/]\ndone", "[blue]error[/]\ndone"))
+	// Output:
+	// - "[red]error[/]"
+	// + "[blue]error[/]"
+	//   "done"
+}
+
+/* ---[ TESTS ]--- */
+
+// recorder is a testing.TB that collects the errors instead of failing the test
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Helper() {}
+
+func TestForceColor(t *testing.T) {
+	initial := *brush.Default()
+
+	t.Run("on", func(t *testing.T) {
+		brushtest.ForceColor(t, true)
+		if s := brush.Paint(brush.Red, nil, "x").String(); s != "\x1b[31mx\x1b[0m" {
+			t.Errorf("Expected color to be enabled, got %q", s)
+		}
+	})
+	t.Run("off", func(t *testing.T) {
+		brushtest.ForceColor(t, false)
+		if s := brush.Paint(brush.Red, nil, "x").String(); s != "x" {
+			t.Errorf("Expected color to be disabled, got %q", s)
+		}
+	})
+
+	if *brush.Default() != initial {
+		t.Error("Expected the default renderer to be restored after the tests")
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	brushtest.ForceColor(t, true)
+
+	var (
+		red  = brush.New(brush.Red, nil)
+		bold = brush.NewStyle(brush.Red, nil).WithAttributes(brush.Bold)
+		text = brush.Join("status: ", red.Paint("failed"), " ", bold.Paint("now"))
+	)
+
+	if !brushtest.AssertPlain(t, text, "status: failed now") {
+		return
+	}
+	brushtest.AssertStyle(t, text, "failed", red.Style())
+	brushtest.AssertStyle(t, text, "now", bold)
+	brushtest.AssertStyle(t, text, "status", brush.Style{})
+	brushtest.AssertEqual(t, text, brush.MustMarkup("status: [red]failed[/] [bold red]now[/]"))
+
+	r := &recorder{TB: t}
+	brushtest.AssertPlain(r, text, "status: ok")
+	brushtest.AssertStyle(r, text, "failed now", red.Style())
+	brushtest.AssertStyle(r, text, "missing", red.Style())
+	brushtest.AssertEqual(r, text, red.Paint("status: failed now"))
+
+	if len(r.errors) != 4 {
+		t.Fatalf("Expected 4 failures, got %d: %q", len(r.errors), r.errors)
+	}
+
+	painted := red.Paint("failed")
+	brushtest.AssertStyle(t, &painted, "failed", red.Style())
+	brushtest.AssertEqual(t, &painted, brush.MustMarkup("[red]failed[/]"))
+	if !strings.Contains(r.errors[1], "[none] instead of [red] at byte 6") {
+		t.Errorf("Expected failure to name the styles, got:\n%s", r.errors[1])
+	}
+	if !strings.Contains(r.errors[3], `+ "status: [red]failed[/] [bold red]now[/]"`) {
+		t.Errorf("Expected failure to show a diff, got:\n%s", r.errors[3])
+	}
+}
+
+func TestAssertions_disabled(t *testing.T) {
+	brushtest.ForceColor(t, false)
+
+	var (
+		r    = &recorder{TB: t}
+		red  = brush.Paint(brush.Red, nil, "text")
+		blue = brush.Paint(brush.Blue, nil, "text")
+	)
+
+	brushtest.AssertEqual(r, red, blue)
+	brushtest.AssertStyle(r, &red, "text", red.Style())
+	brushtest.AssertGolden(r, blue, "disabled")
+	if len(r.errors) != 3 || !strings.Contains(r.errors[0], "disabled") {
+		t.Errorf("Expected disabled values to fail, got %q", r.errors)
+	}
+
+	r.errors = nil
+	brushtest.AssertEqual(r, brush.Join(red), brush.Join(blue))
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "ForceColor") {
+		t.Errorf("Expected joined values to fail while disabled, got %q", r.errors)
+	}
+	brushtest.AssertPlain(t, red, "text")
+}
+
+func TestAssertGolden(t *testing.T) {
+	brushtest.ForceColor(t, true)
+
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	text := brush.Join("[", brush.Paint(brush.Green, nil, "ok"), "]\n")
+
+	r := &recorder{TB: t}
+	if brushtest.AssertGolden(r, text, "status") || len(r.errors) != 1 {
+		t.Errorf("Expected a missing golden file to fail, got %q", r.errors)
+	}
+
+	os.Mkdir("testdata", 0o755)
+	if err = os.WriteFile(filepath.Join("testdata", "status.golden"), []byte("\\[[green]ok[/]]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	brushtest.AssertGolden(t, text, "status")
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DazFather/brush"
+)
+
+const colorUsage = `Usage:
+  brush color table [16|256|truecolor]       print the table of the colors (all if none is given)
+  brush color convert <color>                show the color in the other formats
+  brush color contrast <font> <background>   report the contrast between two colors
+Colors can be written as names ("red", "bright-red"), 256-index ("208"), hex ("#ff8700") or rgb ("rgb(255,135,0)")`
+
+func colorCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, colorUsage)
+		return 2
+	}
+
+	var err error
+	switch command, args := args[0], args[1:]; {
+	case command == "table" && len(args) <= 1:
+		err = colorTable(os.Stdout, args)
+	case command == "convert" && len(args) == 1:
+		err = colorConvert(os.Stdout, args[0])
+	case command == "contrast" && len(args) == 2:
+		err = colorContrast(os.Stdout, args[0], args[1])
+	default:
+		fmt.Fprintln(os.Stderr, colorUsage)
+		return 2
+	}
+
+	if err != nil {
+		fail(err)
+		return 1
+	}
+	return 0
+}
+
+// swatch paints the label on the given background with a readable font color
+func swatch[color brush.ColorType](background color, label string) brush.Painted {
+	bg := brush.ToColor(background)
+	font, _ := brush.Readable(bg, brush.ContrastAA, brush.ToColor(brush.Black), brush.ToColor(brush.BrightWhite))
+	return brush.Paint(font, brush.UseColor(bg), label)
+}
+
+func colorTable(w io.Writer, args []string) error {
+	tables := map[string]func(io.Writer){"16": ansiTable, "256": extendedTable, "truecolor": trueColorTable}
+	if len(args) == 0 {
+		for _, name := range [...]string{"16", "256", "truecolor"} {
+			fmt.Fprintln(w, name, "colors:")
+			tables[name](w)
+			fmt.Fprintln(w)
+		}
+		return nil
+	}
+
+	table, ok := tables[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown table %q, expected 16, 256 or truecolor", args[0])
+	}
+	table(w)
+	return nil
+}
+
+func ansiTable(w io.Writer) {
+	for _, from := range [...]brush.ANSIColor{brush.Black, brush.BrightBlack} {
+		var row brush.Highlighted
+		for c := from; c < from+8; c++ {
+			row.Append(swatch(c, fmt.Sprintf(" %-14s", brush.ToColor(c))))
+		}
+		fmt.Fprintln(w, row)
+	}
+}
+
+func extendedTable(w io.Writer) {
+	for c := brush.ExtendedANSIColor(0); c < 16; c++ {
+		fmt.Fprint(w, swatch(c, fmt.Sprintf(" %3d", c)))
+	}
+	fmt.Fprintln(w)
+
+	// the RGB cube is split in two halves, each one with 3 blocks of 6x6 colors
+	for _, half := range [...]brush.ColorIntensity{brush.ZeroIntensity, brush.MediumIntensity} {
+		for green := brush.ZeroIntensity; green <= brush.MaxIntensity; green++ {
+			var row brush.Highlighted
+			for red := half; red < half+3; red++ {
+				for blue := brush.ZeroIntensity; blue <= brush.MaxIntensity; blue++ {
+					c := brush.RGB(red, green, blue)
+					row.Append(swatch(c, fmt.Sprintf(" %3d", c)))
+				}
+				row.Append(" ")
+			}
+			fmt.Fprintln(w, row)
+		}
+	}
+
+	for _, from := range [...]uint8{1, 13} {
+		var row brush.Highlighted
+		for gray := from; gray < from+12; gray++ {
+			c := brush.GrayScale(gray)
+			row.Append(swatch(c, fmt.Sprintf(" %3d", c)))
+		}
+		fmt.Fprintln(w, row)
+	}
+}
+
+func trueColorTable(w io.Writer) {
+	const width = 72
+
+	ramps := []func(i int) brush.TrueColor{
+		func(i int) brush.TrueColor { return hue(float64(i) / width) },
+		func(i int) brush.TrueColor { return brush.TrueColor{Red: uint8(i * 255 / (width - 1))} },
+		func(i int) brush.TrueColor { return brush.TrueColor{Green: uint8(i * 255 / (width - 1))} },
+		func(i int) brush.TrueColor { return brush.TrueColor{Blue: uint8(i * 255 / (width - 1))} },
+		func(i int) brush.TrueColor {
+			v := uint8(i * 255 / (width - 1))
+			return brush.TrueColor{Red: v, Green: v, Blue: v}
+		},
+	}
+
+	for _, ramp := range ramps {
+		var row brush.Highlighted
+		for i := 0; i < width; i++ {
+			row.Append(brush.Paint(brush.TrueColor{}, brush.UseColor(ramp(i)), " "))
+		}
+		fmt.Fprintln(w, row)
+	}
+}
+
+// hue gives the fully saturated color at the given position (from 0 to 1) of the color wheel
+func hue(position float64) brush.TrueColor {
+	var (
+		h       = position * 6
+		rising  = uint8((h - float64(int(h))) * 255)
+		falling = 255 - rising
+	)
+
+	switch int(h) % 6 {
+	case 0:
+		return brush.TrueColor{Red: 255, Green: rising}
+	case 1:
+		return brush.TrueColor{Red: falling, Green: 255}
+	case 2:
+		return brush.TrueColor{Green: 255, Blue: rising}
+	case 3:
+		return brush.TrueColor{Green: falling, Blue: 255}
+	case 4:
+		return brush.TrueColor{Red: rising, Blue: 255}
+	}
+	return brush.TrueColor{Red: 255, Blue: falling}
+}
+
+func colorConvert(w io.Writer, s string) error {
+	c, err := brush.ParseColor(s)
+	if err != nil {
+		return err
+	}
+
+	var (
+		tc       = c.ToTrueColor()
+		ansi     = tc.ToANSI()
+		extended = tc.ToExtended()
+	)
+	if n, err := strconv.Atoi(c.String()); err == nil {
+		extended = brush.ExtendedANSIColor(n)
+	} else if brush.ToColor(ansi).String() == c.String() {
+		extended = ansi.ToExtended()
+	}
+
+	fmt.Fprintln(w, swatch(tc, strings.Repeat(" ", 8)))
+	fmt.Fprintln(w, "hex:", brush.ToColor(tc))
+	fmt.Fprintf(w, "rgb: rgb(%d, %d, %d)\n", tc.Red, tc.Green, tc.Blue)
+	fmt.Fprintln(w, "256:", extended)
+	fmt.Fprintln(w, "16: ", brush.ToColor(ansi))
+	return nil
+}
+
+func colorContrast(w io.Writer, font, background string) error {
+	fg, err := brush.ParseColor(font)
+	if err != nil {
+		return err
+	}
+	bg, err := brush.ParseColor(background)
+	if err != nil {
+		return err
+	}
+
+	ratio := brush.ContrastRatio(fg, bg)
+	fmt.Fprintln(w, brush.Paint(fg, brush.UseColor(bg), "  The quick brown fox jumps over the lazy dog  "))
+	fmt.Fprintf(w, "contrast: %.2f:1\n", ratio)
+
+	var (
+		pass = brush.New(brush.Green, nil)
+		miss = brush.New(brush.Red, nil)
+	)
+	for _, level := range []struct {
+		name    string
+		minimum float64
+	}{
+		{"AA large", brush.ContrastAALarge},
+		{"AA", brush.ContrastAA},
+		{"AAA", brush.ContrastAAA},
+	} {
+		result := miss.Paint("✖")
+		if ratio >= level.minimum {
+			result = pass.Paint("✔")
+		}
+		fmt.Fprintf(w, "%s %s (%.1f:1)\n", result, level.name, level.minimum)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+func TestColorConvert(t *testing.T) {
+	brush.Disable = true
+	defer func() { brush.Disable = false }()
+
+	var cases = []struct {
+		color    string
+		expected string
+	}{
+		{"208", "hex: #ff8700\nrgb: rgb(255, 135, 0)\n256: 208\n16:  bright-yellow\n"},
+		{"bright-blue", "hex: #0000ff\nrgb: rgb(0, 0, 255)\n256: 12\n16:  bright-blue\n"},
+		{"#5f87af", "hex: #5f87af\nrgb: rgb(95, 135, 175)\n256: 67\n16:  bright-black\n"},
+	}
+
+	for _, c := range cases {
+		var out strings.Builder
+		if err := colorConvert(&out, c.color); err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.SplitN(out.String(), "\n", 2)[1]; got != c.expected {
+			t.Errorf("%s | want: %q got: %q", c.color, c.expected, got)
+		}
+	}
+
+	if err := colorConvert(&strings.Builder{}, "not a color"); err == nil {
+		t.Error("Expected error converting an invalid color")
+	}
+}
+
+func TestColorContrast(t *testing.T) {
+	brush.Disable = true
+	defer func() { brush.Disable = false }()
+
+	var out strings.Builder
+	if err := colorContrast(&out, "#777777", "bright-white"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "contrast: 4.48:1\n✔ AA large (3.0:1)\n✖ AA (4.5:1)\n✖ AAA (7.0:1)\n"
+	if got := strings.SplitN(out.String(), "\n", 2)[1]; got != expected {
+		t.Errorf("want: %q got: %q", expected, got)
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/DazFather/brush"
+)
+
+// grepper searches a pattern in some inputs and writes the matching lines with their context
+type grepper struct {
+	pattern       *regexp.Regexp
+	before, after int
+	invert        bool
+	showNames     bool
+	output        io.Writer
+
+	printed   bool // printed tells if any line has been written, used to separate the groups
+	name      brush.Brush[brush.ANSIColor]
+	number    brush.Brush[brush.ANSIColor]
+	separator brush.Brush[brush.ANSIColor]
+	match     brush.Brush[brush.ANSIColor]
+}
+
+type numberedLine struct {
+	number int
+	text   string
+}
+
+func grep(args []string) int {
+	flags := flag.NewFlagSet("grep", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: brush grep [options] <pattern> [files or directories...]")
+		fmt.Fprintln(flags.Output(), "Search for a regular expression in the files, recursively in the directories or in the standard input if none is given")
+		flags.PrintDefaults()
+	}
+
+	var (
+		after      = flags.Int("A", 0, "print `NUM` lines of context after each match")
+		before     = flags.Int("B", 0, "print `NUM` lines of context before each match")
+		context    = flags.Int("C", 0, "print `NUM` lines of context before and after each match")
+		ignoreCase = flags.Bool("i", false, "ignore case distinctions")
+		fixed      = flags.Bool("F", false, "interpret the pattern as a fixed string instead of a regular expression")
+		invert     = flags.Bool("v", false, "select the lines that do not match")
+	)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	pattern := flags.Arg(0)
+	if *fixed {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if *ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+	rgx, err := regexp.Compile(pattern)
+	if err != nil {
+		fail(err)
+		return 2
+	}
+
+	g := newGrepper(rgx, os.Stdout)
+	g.before, g.after, g.invert = max(*before, *context), max(*after, *context), *invert
+
+	paths := flags.Args()[1:]
+	g.showNames = len(paths) > 1
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	var found, failed bool
+	for _, path := range paths {
+		matched, err := g.searchPath(path)
+		found = found || matched
+		if err != nil {
+			fail(err)
+			failed = true
+		}
+	}
+
+	switch {
+	case failed:
+		return 2
+	case found:
+		return 0
+	}
+	return 1
+}
+
+func newGrepper(pattern *regexp.Regexp, output io.Writer) *grepper {
+	g := &grepper{
+		pattern:   pattern,
+		output:    output,
+		name:      brush.New(brush.Magenta, nil),
+		number:    brush.New(brush.Green, nil),
+		separator: brush.New(brush.Cyan, nil),
+		match:     brush.New(brush.Red, nil),
+	}
+	g.match.UseAttributes(brush.Bold)
+
+	return g
+}
+
+// searchPath searches in the file or recursively in the directory at the given path, "-" means the standard input
+func (g *grepper) searchPath(path string) (found bool, err error) {
+	if path == "-" {
+		return g.search("(standard input)", os.Stdin)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	if !info.IsDir() {
+		return g.searchFile(path)
+	}
+
+	g.showNames = true
+	err = filepath.WalkDir(path, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fail(err)
+			return nil
+		}
+		if d.Type().IsRegular() {
+			matched, err := g.searchFile(name)
+			found = found || matched
+			if err != nil {
+				fail(err)
+			}
+		}
+		return nil
+	})
+
+	return found, err
+}
+
+func (g *grepper) searchFile(name string) (bool, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	// binary files are skipped
+	r := bufio.NewReader(file)
+	if head, _ := r.Peek(512); bytes.IndexByte(head, 0) >= 0 {
+		return false, nil
+	}
+
+	return g.search(name, r)
+}
+
+// search writes the lines of r that are selected and their context
+func (g *grepper) search(name string, r io.Reader) (found bool, err error) {
+	var (
+		scanner   = bufio.NewScanner(r)
+		out       = bufio.NewWriter(g.output)
+		previous  []numberedLine
+		last      = -1 // last is the number of the last line written, -1 so that groups of different inputs are separated
+		afterLeft int
+	)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	defer out.Flush()
+
+	write := func(line numberedLine, selected bool) {
+		if g.printed && last+1 < line.number && (g.before > 0 || g.after > 0) {
+			fmt.Fprintln(out, g.separator.Paint("--"))
+		}
+
+		delimiter := g.separator.Paint("-")
+		if selected {
+			delimiter = g.separator.Paint(":")
+		}
+
+		var res brush.Highlighted
+		if g.showNames {
+			res.Append(g.name.Paint(name), delimiter)
+		}
+		res.Append(g.number.Paint(strconv.Itoa(line.number)), delimiter)
+		if selected && !g.invert {
+			res.Append(g.match.Highlight(line.text, g.pattern))
+		} else {
+			res.Append(line.text)
+		}
+		fmt.Fprintln(out, res)
+		last, g.printed = line.number, true
+	}
+
+	for number := 1; scanner.Scan(); number++ {
+		line := numberedLine{number, scanner.Text()}
+
+		if g.pattern.MatchString(line.text) != g.invert {
+			found = true
+			for _, p := range previous {
+				write(p, false)
+			}
+			previous = previous[:0]
+			write(line, true)
+			afterLeft = g.after
+			continue
+		}
+
+		if afterLeft > 0 {
+			write(line, false)
+			afterLeft--
+		} else if g.before > 0 {
+			if len(previous) == g.before {
+				previous = previous[1:]
+			}
+			previous = append(previous, line)
+		}
+	}
+
+	return found, scanner.Err()
+}
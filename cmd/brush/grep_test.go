@@ -0,0 +1,64 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+func TestGrepper_search(t *testing.T) {
+	brush.Disable = true
+	defer func() { brush.Disable = false }()
+
+	const input = "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten"
+
+	var cases = []struct {
+		name          string
+		pattern       string
+		before, after int
+		invert        bool
+		expected      string
+	}{
+		{"plain", "e$", 0, 0, false, "1:one\n3:three\n5:five\n9:nine\n"},
+		{"context", "^(three|nine)$", 1, 1, false, "2-two\n3:three\n4-four\n--\n8-eight\n9:nine\n10-ten\n"},
+		{"overlapping context", "^f", 2, 0, false, "2-two\n3-three\n4:four\n5:five\n"},
+		{"invert", "[aeiou]", 0, 0, true, ""},
+		{"invert with letters", "e", 0, 0, true, "2:two\n4:four\n6:six\n"},
+	}
+
+	for _, c := range cases {
+		var out strings.Builder
+		g := newGrepper(regexp.MustCompile(c.pattern), &out)
+		g.before, g.after, g.invert = c.before, c.after, c.invert
+
+		found, err := g.search("numbers.txt", strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out.String(); got != c.expected {
+			t.Errorf("%s | want: %q got: %q", c.name, c.expected, got)
+		}
+		if found != (c.expected != "") {
+			t.Errorf("%s | unexpected found: %t", c.name, found)
+		}
+	}
+}
+
+func TestGrepper_highlight(t *testing.T) {
+	brush.Disable, brush.DisableIfNotTTY = false, false
+
+	var out strings.Builder
+	g := newGrepper(regexp.MustCompile("wo"), &out)
+	g.showNames = true
+
+	if _, err := g.search("a.txt", strings.NewReader("one\ntwo")); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "\x1b[35ma.txt\x1b[0m\x1b[36m:\x1b[0m\x1b[32m2\x1b[0m\x1b[36m:\x1b[0mt\x1b[1;31mwo\x1b[0m\n"
+	if got := out.String(); got != expected {
+		t.Errorf("want: %q got: %q", expected, got)
+	}
+}
@@ -0,0 +1,64 @@
+// Command brush is a set of colorful tools built on top of the brush library.
+//
+// Usage:
+//
+//	brush <command> [arguments]
+//
+// The commands are:
+//
+//	color   preview, convert and check the contrast of colors
+//	grep    search for a pattern in files and directories
+//
+// Colors are disabled when the output is not a terminal or the NO_COLOR environment variable is set
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/DazFather/brush"
+)
+
+var commands = map[string]func(args []string) int{
+	"grep":  grep,
+	"color": colorCommand,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	command, ok := commands[os.Args[1]]
+	if !ok {
+		if name := os.Args[1]; name != "help" && name != "-h" && name != "--help" {
+			fail(fmt.Errorf("unknown command %q", name))
+		}
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	os.Exit(command(os.Args[2:]))
+}
+
+func usage(w io.Writer) {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: brush <command> [arguments]")
+	fmt.Fprintln(w, "Commands:")
+	for _, name := range names {
+		fmt.Fprintln(w, "  ", name)
+	}
+}
+
+// fail prints the error on the standard error
+func fail(err error) {
+	fmt.Fprint(os.Stderr, "[", brush.Paint(brush.Red, nil, "ERROR"), "]: ", err, "\n")
+}
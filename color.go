@@ -9,8 +9,9 @@ import (
 
 // ColorType represents a color from any set
 type ColorType interface {
-	ANSIColor | ExtendedANSIColor | TrueColor
+	ANSIColor | ExtendedANSIColor | TrueColor | AlphaColor | Color
 
+	ToTrueColor() TrueColor
 	foreground() string
 	background() string
 }
@@ -42,18 +43,18 @@ func (c ANSIColor) foreground() string {
 	col := int(c)
 
 	if col < 8 {
-		return fmt.Sprint(col + 30)
+		return strconv.Itoa(col + 30)
 	}
-	return fmt.Sprint(col + 82)
+	return strconv.Itoa(col + 82)
 }
 
 func (c ANSIColor) background() string {
 	col := int(c)
 
 	if col < 8 {
-		return fmt.Sprint(col + 40)
+		return strconv.Itoa(col + 40)
 	}
-	return fmt.Sprint(col + 92)
+	return strconv.Itoa(col + 92)
 }
 
 // ExtendedANSIColor represents a color from the extended ANSI table (256 colors)
@@ -103,11 +104,11 @@ func GrayScale(grayScale uint8) ExtendedANSIColor {
 }
 
 func (c ExtendedANSIColor) foreground() string {
-	return fmt.Sprint("38;5;", int(c))
+	return "38;5;" + strconv.Itoa(int(c))
 }
 
 func (c ExtendedANSIColor) background() string {
-	return fmt.Sprint("48;5;", int(c))
+	return "48;5;" + strconv.Itoa(int(c))
 }
 
 // Optional represents an optional color
@@ -137,32 +138,99 @@ const (
 
 type style struct {
 	foreground, background string
+	attributes             Attribute
+	link, linkID           string
 }
 
 func serialize[color ColorType](foreground color, background Optional[color]) style {
 	var s = style{foreground: foreground.foreground()}
 	if background != nil {
 		s.background = (*background).background()
+
+		// a translucent font lies on top of the background, not on the Backdrop
+		if font, ok := translucent(foreground); ok {
+			s.foreground = font.Over((*background).ToTrueColor()).foreground()
+		}
 	}
 
 	return s
 }
 
-func (b *Brush[color]) extract() style {
-	return serialize(b.Foreground, b.Background)
+// translucent gives back the AlphaColor that is used by the given color, looking also inside Color
+func translucent(c any) (AlphaColor, bool) {
+	switch v := c.(type) {
+	case AlphaColor:
+		return v, true
+	case Color:
+		return translucent(v.value)
+	case adaptive:
+		return translucent(v.pick())
+	}
+	return AlphaColor{}, false
 }
 
-func (s style) apply(content string) string {
-	style := s.foreground
-	if len(s.background) > 0 {
-		style += ";" + s.background
+func (b Brush[color]) extract() style {
+	s := serialize(b.Foreground, b.Background)
+	s.attributes = b.Attributes
+	s.link, s.linkID = b.link, b.linkID
+	return b.settings().adapt(s)
+}
+
+// decodeColor converts back to a Color the SGR parameters of a font or background color
+func decodeColor(code string) (Color, bool) {
+	var params []int
+	for _, p := range strings.Split(code, ";") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return Color{}, false
+		}
+		params = append(params, n)
+	}
+
+	switch n := params[0]; {
+	case len(params) == 1 && (n >= 30 && n <= 37 || n >= 40 && n <= 47):
+		return ToColor(ANSIColor(n % 10)), true
+	case len(params) == 1 && (n >= 90 && n <= 97 || n >= 100 && n <= 107):
+		return ToColor(ANSIColor(n%10) + BrightBlack), true
+	case (n == 38 || n == 48) && len(params) == 3 && params[1] == 5:
+		return ToColor(ExtendedANSIColor(params[2])), true
+	case (n == 38 || n == 48) && len(params) == 5 && params[1] == 2:
+		return ToColor(TrueColor{uint8(params[2]), uint8(params[3]), uint8(params[4])}), true
 	}
 
-	if style == "" {
-		return content
+	return Color{}, false
+}
+
+// brush creates a new Brush that uses the style as default
+func (s style) brush() Brush[Color] {
+	var b = New(Color{}, nil)
+	if c, ok := decodeColor(s.foreground); ok {
+		b.defForeground = c
 	}
+	if c, ok := decodeColor(s.background); ok {
+		b.defBackground = UseColor(c)
+	}
+	b.Attributes = s.attributes
+	b.link, b.linkID = s.link, s.linkID
 
-	return fmt.Sprintf("%s%sm%s%sm", csi, style, content, csi+colorReset)
+	return *b.UseDefaultColor()
+}
+
+// merge gives back the style with the given one on top: the colors that are set
+// are overridden and the attributes are combined
+func (s style) merge(over style) style {
+	if over.foreground != "" {
+		s.foreground = over.foreground
+	}
+	if over.background != "" {
+		s.background = over.background
+	}
+	s.attributes |= over.attributes
+	if over.link != "" {
+		s.link, s.linkID = over.link, over.linkID
+	}
+
+	return s
 }
 
 // TrueColor is a true RGB color representation.
@@ -171,12 +239,28 @@ type TrueColor struct {
 	Red, Green, Blue uint8
 }
 
+// ToTrueColor gives back the same color, it exists so that any ColorType can be converted to a TrueColor
+func (c TrueColor) ToTrueColor() TrueColor {
+	return c
+}
+
 func (c TrueColor) foreground() string {
-	return fmt.Sprint("38;2;", c.Red, ";", c.Green, ";", c.Blue)
+	return c.sgr("38;2;")
 }
 
 func (c TrueColor) background() string {
-	return fmt.Sprint("48;2;", c.Red, ";", c.Green, ";", c.Blue)
+	return c.sgr("48;2;")
+}
+
+func (c TrueColor) sgr(prefix string) string {
+	var buf = make([]byte, 0, len(prefix)+11)
+	buf = append(buf, prefix...)
+	buf = strconv.AppendUint(buf, uint64(c.Red), 10)
+	buf = append(buf, ';')
+	buf = strconv.AppendUint(buf, uint64(c.Green), 10)
+	buf = append(buf, ';')
+	buf = strconv.AppendUint(buf, uint64(c.Blue), 10)
+	return string(buf)
 }
 
 // ParseHex parses a hexadecimal string representing a color and returns a TrueColor pointer
@@ -187,19 +271,55 @@ func (c TrueColor) background() string {
 //   - "RGB": Represents a shorthand version of "#RRGGBB" where each component is a single digit
 //     and duplicated, e.g, "ABC" is equivalent to "AABBCC"
 func ParseHex(hex string) (*TrueColor, error) {
-	var chunk int
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 3 && len(hex) != 6 {
+		return nil, fmt.Errorf("Cannot parse %s color: Invalid hex length, must be 3 or 6 digits long (excluding optional prefix '#')", hex)
+	}
+
+	nums, err := parseHexComponents(hex, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrueColor{Red: nums[0], Green: nums[1], Blue: nums[2]}, nil
+}
 
+// ParseHexAlpha is like ParseHex but it also accepts the formats with the alpha channel:
+//
+//   - "RRGGBBAA": Like "RRGGBB" but the last component represents the opacity from 00 to FF.
+//   - "RGBA": Represents a shorthand version of "#RRGGBBAA"
+//
+// When the alpha channel is not given the color will be fully opaque
+func ParseHexAlpha(hex string) (*AlphaColor, error) {
 	hex = strings.TrimPrefix(hex, "#")
+
+	var components int
 	switch len(hex) {
-	case 3:
-		chunk = 1
-	case 6:
-		chunk = 2
+	case 3, 6:
+		components = 3
+	case 4, 8:
+		components = 4
 	default:
-		return nil, fmt.Errorf("Cannot parse %s color: Invalid hex length, must be 3 or 6 digits long (excluding optional prefix '#')", hex)
+		return nil, fmt.Errorf("Cannot parse %s color: Invalid hex length, must be 3, 4, 6 or 8 digits long (excluding optional prefix '#')", hex)
 	}
 
-	nums := [3]uint8{}
+	nums, err := parseHexComponents(hex, components)
+	if err != nil {
+		return nil, err
+	}
+
+	c := AlphaColor{Red: nums[0], Green: nums[1], Blue: nums[2], Alpha: 255}
+	if components == 4 {
+		c.Alpha = nums[3]
+	}
+	return &c, nil
+}
+
+// parseHexComponents splits hex in the given number of components of one or two digits each
+func parseHexComponents(hex string, components int) ([]uint8, error) {
+	chunk := len(hex) / components
+
+	nums := make([]uint8, components)
 	for i := range nums {
 		n, err := strconv.ParseUint(hex[i*chunk:(i+1)*chunk], 16, 8)
 		if err != nil {
@@ -211,46 +331,55 @@ func ParseHex(hex string) (*TrueColor, error) {
 		nums[i] = uint8(n)
 	}
 
-	return &TrueColor{Red: nums[0], Green: nums[1], Blue: nums[2]}, nil
+	return nums, nil
+}
+
+// Backdrop is the color used as the background of the terminal when compositing
+// translucent colors, by default is black.
+// Set it to match the background of your terminal to get more accurate results
+var Backdrop = TrueColor{0, 0, 0}
+
+// AlphaColor is a TrueColor with an alpha channel that specify its opacity,
+// from 0 (fully transparent) to 255 (fully opaque).
+// Terminals do not support translucent colors so they will be composited:
+// a font over the background of the brush if given, otherwise over the Backdrop
+type AlphaColor struct {
+	Red, Green, Blue, Alpha uint8
 }
 
-// ToTrueColor transforms an ANSIColor to a standard TrueColor representation.
-// Be aware that the actual color might be different from the original,
+// Over composites the color on top of the given (opaque) one
+func (c AlphaColor) Over(bg TrueColor) TrueColor {
+	blend := func(fg, bg uint8) uint8 {
+		return uint8((int(fg)*int(c.Alpha) + int(bg)*(255-int(c.Alpha)) + 127) / 255)
+	}
+
+	return TrueColor{
+		Red:   blend(c.Red, bg.Red),
+		Green: blend(c.Green, bg.Green),
+		Blue:  blend(c.Blue, bg.Blue),
+	}
+}
+
+// ToTrueColor transforms an AlphaColor to a TrueColor by compositing it over the Backdrop
+func (c AlphaColor) ToTrueColor() TrueColor {
+	return c.Over(Backdrop)
+}
+
+func (c AlphaColor) foreground() string {
+	return c.ToTrueColor().foreground()
+}
+
+func (c AlphaColor) background() string {
+	return c.ToTrueColor().background()
+}
+
+// ToTrueColor transforms an ANSIColor to a TrueColor representation using the palette of the Default renderer
+// (the ActivePalette unless replaced). Be aware that the actual color might be different from the original,
 // because the visible color might be different from the one of your terminal.
 func (c ANSIColor) ToTrueColor() (tc TrueColor) {
-	switch c {
-	case Black:
-		tc = TrueColor{0, 0, 0}
-	case Red:
-		tc = TrueColor{128, 0, 0}
-	case Green:
-		tc = TrueColor{0, 128, 0}
-	case Yellow:
-		tc = TrueColor{128, 128, 0}
-	case Blue:
-		tc = TrueColor{0, 0, 128}
-	case Magenta:
-		tc = TrueColor{128, 0, 128}
-	case Cyan:
-		tc = TrueColor{0, 128, 128}
-	case White:
-		tc = TrueColor{192, 192, 192}
-	case BrightBlack:
-		tc = TrueColor{128, 128, 128}
-	case BrightRed:
-		tc = TrueColor{255, 0, 0}
-	case BrightGreen:
-		tc = TrueColor{0, 255, 0}
-	case BrightYellow:
-		tc = TrueColor{255, 255, 0}
-	case BrightBlue:
-		tc = TrueColor{0, 0, 255}
-	case BrightMagenta:
-		tc = TrueColor{255, 0, 255}
-	case BrightCyan:
-		tc = TrueColor{0, 255, 255}
-	case BrightWhite:
-		tc = TrueColor{255, 255, 255}
+	if c >= Black && c <= BrightWhite {
+		r := defaults()
+		tc = r.palette()[c]
 	}
 	return
 }
@@ -288,3 +417,167 @@ func (c ExtendedANSIColor) ToTrueColor() TrueColor {
 		Red:   nums[2], // (ansi / 36) % 6,
 	}
 }
+
+// Color can hold a color of any ColorType, it's useful when the type is known only at runtime,
+// like when it's loaded from a configuration file. Use ToColor or ParseColor to create one.
+// The zero value represents the default color of the terminal and will not be applied
+type Color struct {
+	value interface {
+		ToTrueColor() TrueColor
+		foreground() string
+		background() string
+	}
+}
+
+// ToColor wraps a color of any ColorType into a Color
+func ToColor[color ColorType](c color) Color {
+	if wrapped, ok := any(c).(Color); ok {
+		return wrapped
+	}
+	return Color{value: c}
+}
+
+// ToTrueColor transforms the Color to a TrueColor representation.
+// The default color of the terminal is considered as the Backdrop
+func (c Color) ToTrueColor() TrueColor {
+	if c.value == nil {
+		return Backdrop
+	}
+	return c.value.ToTrueColor()
+}
+
+func (c Color) foreground() string {
+	if c.value == nil {
+		return ""
+	}
+	return c.value.foreground()
+}
+
+func (c Color) background() string {
+	if c.value == nil {
+		return ""
+	}
+	return c.value.background()
+}
+
+var colorNames = [...]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// ParseColor converts a string into a Color, the supported formats are:
+//
+//   - "default" or "": the default color of the terminal
+//   - "red", "bright-red", ...: the name of an ANSIColor ("bright" can also be used without "-")
+//   - "0" to "255": the index of an ExtendedANSIColor
+//   - "#RRGGBB" or "#RGB": an hexadecimal TrueColor (the "#" is required)
+//   - "#RRGGBBAA" or "#RGBA": an hexadecimal AlphaColor (the "#" is required)
+//   - "rgb(R, G, B)": a TrueColor with each component in the range 0 to 255
+//   - "LIGHT|DARK": an Adaptive color made of two colors in one of the previous formats
+func ParseColor(s string) (Color, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if light, dark, found := strings.Cut(s, "|"); found {
+		l, err := ParseColor(light)
+		if err != nil {
+			return Color{}, err
+		}
+		d, err := ParseColor(dark)
+		if err != nil {
+			return Color{}, err
+		}
+		return Adaptive(l, d), nil
+	}
+
+	switch {
+	case s == "" || s == "default":
+		return Color{}, nil
+	case strings.HasPrefix(s, "#"):
+		if len(s) == 5 || len(s) == 9 {
+			c, err := ParseHexAlpha(s)
+			if err != nil {
+				return Color{}, err
+			}
+			return ToColor(*c), nil
+		}
+		c, err := ParseHex(s)
+		if err != nil {
+			return Color{}, err
+		}
+		return ToColor(*c), nil
+	case strings.HasPrefix(s, "rgb(") && strings.HasSuffix(s, ")"):
+		var (
+			components = strings.Split(s[len("rgb("):len(s)-1], ",")
+			nums       [3]uint8
+		)
+		if len(components) != len(nums) {
+			return Color{}, fmt.Errorf("Cannot parse %s color: Expected 3 components", s)
+		}
+		for i, component := range components {
+			n, err := strconv.ParseUint(strings.TrimSpace(component), 10, 8)
+			if err != nil {
+				return Color{}, fmt.Errorf("Cannot parse %s color: %w", s, err)
+			}
+			nums[i] = uint8(n)
+		}
+		return ToColor(TrueColor{Red: nums[0], Green: nums[1], Blue: nums[2]}), nil
+	}
+
+	if n, err := strconv.ParseUint(s, 10, 8); err == nil {
+		return ToColor(ExtendedANSIColor(n)), nil
+	}
+
+	name, bright := strings.CutPrefix(s, "bright")
+	if bright {
+		name = strings.TrimPrefix(name, "-")
+	}
+	for i, colorName := range colorNames {
+		if colorName == name {
+			c := ANSIColor(i)
+			if bright {
+				c += BrightBlack
+			}
+			return ToColor(c), nil
+		}
+	}
+
+	return Color{}, fmt.Errorf("Cannot parse %s color: Unknown format", s)
+}
+
+// MustParseColor is like ParseColor but panics if the string cannot be parsed
+func MustParseColor(s string) Color {
+	c, err := ParseColor(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// String gives back the representation of the color in the same format accepted by ParseColor
+func (c Color) String() string {
+	switch v := c.value.(type) {
+	case ANSIColor:
+		if v >= BrightBlack && v <= BrightWhite {
+			return "bright-" + colorNames[v-BrightBlack]
+		} else if v >= Black && v < BrightBlack {
+			return colorNames[v]
+		}
+	case ExtendedANSIColor:
+		return strconv.Itoa(int(v))
+	case TrueColor:
+		return fmt.Sprintf("#%02x%02x%02x", v.Red, v.Green, v.Blue)
+	case AlphaColor:
+		return fmt.Sprintf("#%02x%02x%02x%02x", v.Red, v.Green, v.Blue, v.Alpha)
+	case adaptive:
+		return v.light.String() + "|" + v.dark.String()
+	}
+	return "default"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (c *Color) UnmarshalText(text []byte) (err error) {
+	*c, err = ParseColor(string(text))
+	return
+}
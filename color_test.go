@@ -117,6 +117,79 @@ func ExampleParseHex() {
 	// Cannot parse daz color: strconv.ParseUint: parsing "z": invalid syntax
 }
 
+func ExampleParseHexAlpha() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	var (
+		color *brush.AlphaColor
+		err   error
+	)
+
+	// Example with full RGBA format (# is optional)
+	if color, err = brush.ParseHexAlpha("#FFA50080"); err == nil {
+		fmt.Println("Translucent yellow:", *color)
+	}
+
+	// Example without the alpha channel
+	if color, err = brush.ParseHexAlpha("F00"); err == nil {
+		fmt.Println("Red:", *color)
+	}
+
+	// Bad examples
+	_, err = brush.ParseHexAlpha("#12345")
+	fmt.Println(err)
+
+	// Output:
+	// Translucent yellow: {255 165 0 128}
+	// Red: {255 0 0 255}
+	// Cannot parse 12345 color: Invalid hex length, must be 3, 4, 6 or 8 digits long (excluding optional prefix '#')
+}
+
+func ExampleAlphaColor() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	var (
+		glass   = brush.AlphaColor{Red: 255, Green: 255, Blue: 255, Alpha: 128}
+		myBrush = brush.New(glass, nil)
+	)
+
+	brush.Backdrop = brush.TrueColor{Red: 0, Green: 0, Blue: 0}
+	myBrush.Println("on a dark terminal")
+
+	brush.Backdrop = brush.TrueColor{Red: 255, Green: 255, Blue: 255}
+	myBrush.Println("on a light terminal")
+	brush.Backdrop = brush.TrueColor{}
+
+	// Output:
+	// [38;2;128;128;128mon a dark terminal
+	// [0m[38;2;255;255;255mon a light terminal
+	// [0m
+}
+
+func ExampleParseColor() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	for _, s := range []string{"bright-red", "208", "#FFA500", "rgb(255, 82, 197)", "default"} {
+		color, err := brush.ParseColor(s)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(brush.Paint(color, nil, color))
+	}
+
+	_, err := brush.ParseColor("orange")
+	fmt.Println(err)
+
+	// Output:
+	// [91mbright-red[0m
+	// [38;5;208m208[0m
+	// [38;2;255;165;0m#ffa500[0m
+	// [38;2;255;82;197m#ff52c5[0m
+	// default
+	// Cannot parse orange color: Unknown format
+}
+
 /* ---[ TESTS ]--- */
 
 func TestANSIColor_ToTrueColor(t *testing.T) {
@@ -176,3 +249,98 @@ func TestExtendedANSIColor_ToTrueColor(t *testing.T) {
 		}
 	}
 }
+
+func TestAlphaColor_Over(t *testing.T) {
+	tests := []struct {
+		input    brush.AlphaColor
+		bg       brush.TrueColor
+		expected brush.TrueColor
+	}{
+		{brush.AlphaColor{255, 0, 0, 255}, brush.TrueColor{0, 0, 255}, brush.TrueColor{255, 0, 0}},
+		{brush.AlphaColor{255, 0, 0, 0}, brush.TrueColor{0, 0, 255}, brush.TrueColor{0, 0, 255}},
+		{brush.AlphaColor{255, 0, 0, 128}, brush.TrueColor{0, 0, 255}, brush.TrueColor{128, 0, 127}},
+		{brush.AlphaColor{200, 100, 50, 51}, brush.TrueColor{0, 0, 0}, brush.TrueColor{40, 20, 10}},
+	}
+
+	for _, test := range tests {
+		result := test.input.Over(test.bg)
+		if result != test.expected {
+			t.Errorf("%v.Over(%v): got %v, want %v", test.input, test.bg, result, test.expected)
+		}
+	}
+}
+
+func TestAlphaColor_Paint(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		font    = brush.AlphaColor{255, 255, 255, 128}
+		bg      = brush.AlphaColor{0, 0, 255, 255}
+		myBrush = brush.New(font, &bg)
+	)
+	myBrush.Disable = false
+
+	assert(t, "Painting translucent font over opaque background",
+		myBrush.Paint("glass").String(),
+		"\x1b[38;2;128;128;255;48;2;0;0;255mglass\x1b[0m",
+	)
+
+	wrapped := brush.New(brush.ToColor(font), brush.UseColor(brush.ToColor(bg)))
+	wrapped.Disable = false
+	assert(t, "Painting translucent Color font over opaque background",
+		wrapped.Paint("glass").String(),
+		"\x1b[38;2;128;128;255;48;2;0;0;255mglass\x1b[0m",
+	)
+
+	parsed, err := brush.ParseColor("#ffffff80|#ffffff80")
+	if err != nil {
+		t.Fatal(err)
+	}
+	adaptive := brush.New(parsed, brush.UseColor(brush.ToColor(bg)))
+	adaptive.Disable = false
+	assert(t, "Painting translucent Adaptive font over opaque background",
+		adaptive.Paint("glass").String(),
+		"\x1b[38;2;128;128;255;48;2;0;0;255mglass\x1b[0m",
+	)
+}
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected brush.Color
+	}{
+		{"", brush.Color{}},
+		{"Default", brush.Color{}},
+		{"black", brush.ToColor(brush.Black)},
+		{" Cyan ", brush.ToColor(brush.Cyan)},
+		{"bright-white", brush.ToColor(brush.BrightWhite)},
+		{"brightblue", brush.ToColor(brush.BrightBlue)},
+		{"0", brush.ToColor(brush.ExtendedANSIColor(0))},
+		{"255", brush.ToColor(brush.ExtendedANSIColor(255))},
+		{"#abc", brush.ToColor(brush.TrueColor{0xaa, 0xbb, 0xcc})},
+		{"#ABCDEF", brush.ToColor(brush.TrueColor{0xab, 0xcd, 0xef})},
+		{"#abcd", brush.ToColor(brush.AlphaColor{0xaa, 0xbb, 0xcc, 0xdd})},
+		{"#11223344", brush.ToColor(brush.AlphaColor{0x11, 0x22, 0x33, 0x44})},
+		{"rgb(1,2, 3)", brush.ToColor(brush.TrueColor{1, 2, 3})},
+	}
+
+	for _, test := range tests {
+		result, err := brush.ParseColor(test.input)
+		if err != nil {
+			t.Errorf("ParseColor(%q): unexpected error %v", test.input, err)
+		} else if result != test.expected {
+			t.Errorf("ParseColor(%q): got %v, want %v", test.input, result, test.expected)
+		}
+
+		// String must be parsable back
+		if back, err := brush.ParseColor(result.String()); err != nil || back != result {
+			t.Errorf("ParseColor(%q.String()): got %v (err: %v), want %v", test.input, back, err, result)
+		}
+	}
+
+	for _, invalid := range []string{"256", "-1", "#12", "abc", "bright-", "rgb(1,2)", "rgb(1,2,300)"} {
+		if _, err := brush.ParseColor(invalid); err == nil {
+			t.Errorf("ParseColor(%q): expected an error", invalid)
+		}
+	}
+}
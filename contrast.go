@@ -0,0 +1,143 @@
+package brush
+
+import "math"
+
+// Minimum contrast ratios suggested by the Web Content Accessibility Guidelines (WCAG 2)
+const (
+	ContrastAALarge float64 = 3   // Level AA for large or bold text
+	ContrastAA      float64 = 4.5 // Level AA for normal text
+	ContrastAAA     float64 = 7   // Level AAA for normal text
+)
+
+// Luminance gives the relative luminance of the color as defined by WCAG 2,
+// ranging from 0 (black) to 1 (white)
+func (c TrueColor) Luminance() float64 {
+	r, g, b := c.linear()
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// linear gives the components of the color in the linear RGB space, ranging from 0 to 1
+func (c TrueColor) linear() (r, g, b float64) {
+	return linearize(c.Red), linearize(c.Green), linearize(c.Blue)
+}
+
+func linearize(component uint8) float64 {
+	v := float64(component) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func delinearize(v float64) uint8 {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		v *= 12.92
+	} else {
+		v = 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(v * 255))
+}
+
+// ContrastRatio computes the contrast ratio as defined by WCAG 2 between two colors of any ColorType,
+// ranging from 1 (no contrast) to 21 (black and white)
+func ContrastRatio[first, second ColorType](a first, b second) float64 {
+	return contrast(a.ToTrueColor(), b.ToTrueColor())
+}
+
+func contrast(first, second TrueColor) float64 {
+	l1, l2 := first.Luminance(), second.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// Readable picks the first candidate that reaches the target contrast ratio against the given background.
+// If none of them does, the one with the highest contrast is given back and ok will be false
+func Readable[color ColorType](background color, target float64, candidates ...color) (font color, ok bool) {
+	var best float64
+
+	for _, candidate := range candidates {
+		ratio := ContrastRatio(candidate, background)
+		if ratio >= target {
+			return candidate, true
+		}
+		if ratio > best {
+			font, best = candidate, ratio
+		}
+	}
+
+	return
+}
+
+// AdjustContrast gives back the color lightened or darkened just enough to reach the target contrast
+// ratio against the given background. Between the two, the direction that requires less changes is preferred.
+// If the target cannot be reached then white or black (the one with the highest contrast) is given back
+func (c TrueColor) AdjustContrast(background TrueColor, target float64) TrueColor {
+	if contrast(c, background) >= target {
+		return c
+	}
+
+	var (
+		black, white       = TrueColor{0, 0, 0}, TrueColor{255, 255, 255}
+		darker, okDarker   = c.mixUntil(black, background, target)
+		lighter, okLighter = c.mixUntil(white, background, target)
+	)
+
+	switch {
+	case okDarker && okLighter:
+		if c.Difference(darker) <= c.Difference(lighter) {
+			return darker
+		}
+		return lighter
+	case okDarker:
+		return darker
+	case okLighter:
+		return lighter
+	case contrast(black, background) > contrast(white, background):
+		return black
+	}
+	return white
+}
+
+// mixUntil mixes the color with the given one, as little as possible, until reaching the target contrast
+func (c TrueColor) mixUntil(with, background TrueColor, target float64) (TrueColor, bool) {
+	if contrast(with, background) < target {
+		return with, false
+	}
+
+	var low, high float64 = 0, 1
+	for i := 0; i < 16; i++ {
+		if mid := (low + high) / 2; contrast(c.mix(with, mid), background) >= target {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	return c.mix(with, high), true
+}
+
+// mix the color with another one in the given quantity (0 means none of it, 1 means only it)
+func (c TrueColor) mix(with TrueColor, quantity float64) TrueColor {
+	blend := func(from, to uint8) uint8 {
+		return uint8(math.Round(float64(from) + (float64(to)-float64(from))*quantity))
+	}
+
+	return TrueColor{
+		Red:   blend(c.Red, with.Red),
+		Green: blend(c.Green, with.Green),
+		Blue:  blend(c.Blue, with.Blue),
+	}
+}
+
+// Contrast gives the contrast ratio between the current font and background color of the brush.
+// If the background is transparent the Backdrop will be used instead
+func (b Brush[color]) Contrast() float64 {
+	if b.Background == nil {
+		return contrast(b.Foreground.ToTrueColor(), Backdrop)
+	}
+	return ContrastRatio(b.Foreground, *b.Background)
+}
@@ -0,0 +1,104 @@
+package brush_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleContrastRatio() {
+	fmt.Printf("%.2f\n", brush.ContrastRatio(brush.Black, brush.BrightWhite))
+	fmt.Printf("%.2f\n", brush.ContrastRatio(brush.Blue, brush.Black))
+	fmt.Printf("%.2f\n", brush.ContrastRatio(brush.RGB(5, 5, 0), brush.TrueColor{0, 0, 0}))
+
+	// Output:
+	// 21.00
+	// 1.31
+	// 19.56
+}
+
+func ExampleReadable() {
+	font, ok := brush.Readable(brush.Blue, brush.ContrastAA, brush.Black, brush.Red, brush.BrightWhite)
+	fmt.Println(font == brush.BrightWhite, ok)
+
+	font, ok = brush.Readable(brush.Black, brush.ContrastAAA, brush.Blue, brush.Red)
+	fmt.Println(font == brush.Red, ok)
+
+	// Output:
+	// true true
+	// true false
+}
+
+func ExampleTrueColor_AdjustContrast() {
+	var (
+		navy = brush.TrueColor{Red: 0, Green: 0, Blue: 128}
+		font = brush.TrueColor{Red: 60, Green: 60, Blue: 160}
+	)
+
+	adjusted := font.AdjustContrast(navy, brush.ContrastAA)
+	fmt.Println(brush.ContrastRatio(adjusted, navy) >= brush.ContrastAA)
+
+	// Output: true
+}
+
+func ExampleBrush_Contrast() {
+	myBrush := brush.New(brush.Blue, brush.UseColor(brush.Black))
+
+	fmt.Printf("%.2f\n", myBrush.Contrast())
+	fmt.Printf("%.2f\n", myBrush.UseBgColor(brush.BrightWhite).Contrast())
+
+	// Output:
+	// 1.31
+	// 16.01
+}
+
+/* ---[ TESTS ]--- */
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		a, b     brush.TrueColor
+		expected float64
+	}{
+		{brush.TrueColor{0, 0, 0}, brush.TrueColor{255, 255, 255}, 21},
+		{brush.TrueColor{255, 255, 255}, brush.TrueColor{0, 0, 0}, 21},
+		{brush.TrueColor{100, 100, 100}, brush.TrueColor{100, 100, 100}, 1},
+		{brush.TrueColor{255, 0, 0}, brush.TrueColor{255, 255, 255}, 4},
+	}
+
+	for _, test := range tests {
+		result := brush.ContrastRatio(test.a, test.b)
+		if math.Abs(result-test.expected) > 0.01 {
+			t.Errorf("ContrastRatio(%v, %v): got %.3f, want %.3f", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestTrueColor_AdjustContrast(t *testing.T) {
+	backgrounds := []brush.TrueColor{
+		{0, 0, 0}, {255, 255, 255}, {0, 0, 128}, {128, 128, 128}, {255, 200, 0},
+	}
+	fonts := []brush.TrueColor{
+		{0, 0, 0}, {255, 255, 255}, {128, 0, 0}, {100, 100, 100}, {0, 255, 0},
+	}
+
+	for _, bg := range backgrounds {
+		for _, font := range fonts {
+			adjusted := font.AdjustContrast(bg, brush.ContrastAA)
+			ratio := brush.ContrastRatio(adjusted, bg)
+			if ratio < brush.ContrastAA {
+				t.Errorf("%v.AdjustContrast(%v): got %v with ratio %.2f", font, bg, adjusted, ratio)
+			}
+			if brush.ContrastRatio(font, bg) >= brush.ContrastAA && adjusted != font {
+				t.Errorf("%v.AdjustContrast(%v): got %v, readable color should be unchanged", font, bg, adjusted)
+			}
+		}
+	}
+
+	// impossible target gives back the color with the highest contrast
+	gray := brush.TrueColor{128, 128, 128}
+	assert(t, "Unreachable contrast", gray.AdjustContrast(gray, 22), brush.TrueColor{0, 0, 0})
+}
@@ -0,0 +1,160 @@
+package brush
+
+import (
+	"io"
+	"strconv"
+)
+
+// Control is a sequence that controls the terminal: moves the cursor, clears the screen, etc.
+// It can be printed like any other value or written on any io.Writer using WriteTo.
+// Like Painted it is disabled (it becomes an empty string) if Disable is set or
+// if DisableIfNotTTY is set and the application is not in a tty
+type Control struct {
+	sequence string
+	disable  bool
+}
+
+func control(sequence string) Control {
+	return Control{
+		sequence: sequence,
+		disable:  disabled(),
+	}
+}
+
+func controlN(n int, final string) Control {
+	return control(csi + strconv.Itoa(n) + final)
+}
+
+// String gives the special sequence, empty if the control is disabled
+func (c Control) String() string {
+	if c.disable {
+		return ""
+	}
+	return c.sequence
+}
+
+// WriteTo writes the special sequence on w, nothing is written if the control
+// is disabled or w is not interactive (see Disable and DisableIfNotTTY)
+func (c Control) WriteTo(w io.Writer) (int64, error) {
+	if c.disable || !interactive(w) {
+		return 0, nil
+	}
+
+	n, err := io.WriteString(w, c.sequence)
+	return int64(n), err
+}
+
+// Then gives a control that executes this one followed by the given ones
+func (c Control) Then(others ...Control) Control {
+	for _, other := range others {
+		c.sequence += other.sequence
+		c.disable = c.disable || other.disable
+	}
+	return c
+}
+
+// CursorUp moves the cursor up by n lines
+func CursorUp(n int) Control {
+	return controlN(n, "A")
+}
+
+// CursorDown moves the cursor down by n lines
+func CursorDown(n int) Control {
+	return controlN(n, "B")
+}
+
+// CursorForward moves the cursor right by n columns
+func CursorForward(n int) Control {
+	return controlN(n, "C")
+}
+
+// CursorBack moves the cursor left by n columns
+func CursorBack(n int) Control {
+	return controlN(n, "D")
+}
+
+// CursorNextLine moves the cursor at the beginning of the line n lines down
+func CursorNextLine(n int) Control {
+	return controlN(n, "E")
+}
+
+// CursorPreviousLine moves the cursor at the beginning of the line n lines up
+func CursorPreviousLine(n int) Control {
+	return controlN(n, "F")
+}
+
+// CursorColumn moves the cursor to the given column of the current line (starting from 1)
+func CursorColumn(column int) Control {
+	return controlN(column, "G")
+}
+
+// CursorPosition moves the cursor to the given row and column (starting from 1)
+func CursorPosition(row, column int) Control {
+	return control(csi + strconv.Itoa(row) + ";" + strconv.Itoa(column) + "H")
+}
+
+// SaveCursor stores the position of the cursor so that it can be restored with RestoreCursor
+func SaveCursor() Control {
+	return control(string(esc) + "7")
+}
+
+// RestoreCursor moves the cursor to the position stored with SaveCursor
+func RestoreCursor() Control {
+	return control(string(esc) + "8")
+}
+
+// HideCursor makes the cursor invisible, use ShowCursor to make it visible again
+func HideCursor() Control {
+	return control(csi + "?25l")
+}
+
+// ShowCursor makes the cursor visible
+func ShowCursor() Control {
+	return control(csi + "?25h")
+}
+
+// ClearLine erases the whole line where the cursor is
+func ClearLine() Control {
+	return control(csi + "2K")
+}
+
+// ClearLineRight erases the line where the cursor is from the cursor to the end
+func ClearLineRight() Control {
+	return control(csi + "K")
+}
+
+// ClearLineLeft erases the line where the cursor is from the beginning to the cursor
+func ClearLineLeft() Control {
+	return control(csi + "1K")
+}
+
+// ClearScreen erases the whole screen, the cursor does not move
+func ClearScreen() Control {
+	return control(csi + "2J")
+}
+
+// ClearScreenDown erases the screen from the cursor to the end
+func ClearScreenDown() Control {
+	return control(csi + "J")
+}
+
+// ScrollUp scrolls the content of the screen up by n lines
+func ScrollUp(n int) Control {
+	return controlN(n, "S")
+}
+
+// ScrollDown scrolls the content of the screen down by n lines
+func ScrollDown(n int) Control {
+	return controlN(n, "T")
+}
+
+// EnterAltScreen switches to the alternate screen, that has no scrollback:
+// useful for full-screen applications that want to leave the terminal untouched, see ExitAltScreen
+func EnterAltScreen() Control {
+	return control(csi + "?1049h")
+}
+
+// ExitAltScreen goes back to the main screen, restoring its content
+func ExitAltScreen() Control {
+	return control(csi + "?1049l")
+}
@@ -0,0 +1,75 @@
+package brush_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleControl() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	// rewrite the previous line in red
+	fmt.Printf("%q\n", fmt.Sprintf("%s%s",
+		brush.CursorPreviousLine(1).Then(brush.ClearLine()),
+		brush.Paint(brush.Red, nil, "failed"),
+	))
+	// Output: "\x1b[1F\x1b[2K\x1b[31mfailed\x1b[0m"
+}
+
+/* ---[ TESTS ]--- */
+
+func TestControl(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var cases = []struct {
+		control  brush.Control
+		expected string
+	}{
+		{brush.CursorUp(2), "\x1b[2A"},
+		{brush.CursorPosition(3, 14), "\x1b[3;14H"},
+		{brush.HideCursor(), "\x1b[?25l"},
+		{brush.ClearLineRight(), "\x1b[K"},
+		{brush.EnterAltScreen().Then(brush.ClearScreen(), brush.CursorPosition(1, 1)), "\x1b[?1049h\x1b[2J\x1b[1;1H"},
+	}
+
+	for _, c := range cases {
+		if got := c.control.String(); got != c.expected {
+			t.Errorf("want: %q got: %q", c.expected, got)
+		}
+
+		var out strings.Builder
+		if _, err := c.control.WriteTo(&out); err != nil {
+			t.Error(err)
+		} else if got := out.String(); got != c.expected {
+			t.Errorf("WriteTo | want: %q got: %q", c.expected, got)
+		}
+	}
+}
+
+func TestControl_disabled(t *testing.T) {
+	defer func(previous bool) { brush.Disable = previous }(brush.Disable)
+	brush.Disable = true
+
+	if s := brush.ShowCursor().String(); s != "" {
+		t.Errorf("Expected empty sequence when disabled, got: %q", s)
+	}
+
+	brush.Disable = false
+	brush.DisableIfNotTTY = true
+	defer func() { brush.DisableIfNotTTY = false }()
+
+	f, err := os.CreateTemp(t.TempDir(), "output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if n, _ := brush.ClearScreen().WriteTo(f); n != 0 {
+		t.Errorf("Expected nothing written on a file that is not a terminal, got %d bytes", n)
+	}
+}
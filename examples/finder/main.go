@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"regexp"
 
 	"github.com/DazFather/brush"
@@ -22,18 +24,22 @@ func main() {
 	}
 }
 
-func highlight(pattern, filename string) (err error) {
-	var (
-		marker  = brush.New(brush.Black, brush.UseColor(brush.Yellow))
-		content []byte
-		rgx     *regexp.Regexp
-	)
-
-	if rgx, err = regexp.Compile(pattern); err == nil {
-		if content, err = os.ReadFile(filename); err == nil {
-			fmt.Println(marker.Highlight(string(content), rgx))
-		}
+func highlight(pattern, filename string) error {
+	var marker = brush.New(brush.Black, brush.UseColor(brush.Yellow))
+
+	rgx, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
 	}
+	defer file.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
-	return
+	return brush.NewStreamer(rgx, marker).Stream(ctx, os.Stdout, file)
 }
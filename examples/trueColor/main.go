@@ -8,8 +8,8 @@ import (
 
 func main() {
 	var (
-		pinkish  = brush.TrueColor{255, 82, 197}
-		brownish = brush.TrueColor{155, 106, 0}
+		pinkish  = brush.TrueColor{Red: 255, Green: 82, Blue: 197}
+		brownish = brush.TrueColor{Red: 155, Green: 106, Blue: 0}
 		test     = brush.New(pinkish, &brownish)
 	)
 
@@ -2,7 +2,9 @@ package brush
 
 import (
 	"fmt"
+	"io"
 	"regexp"
+	"strings"
 )
 
 type section struct {
@@ -11,15 +13,11 @@ type section struct {
 }
 
 func (b Brush[color]) newSection(from, to int) section {
-	s := section{
-		from: from,
-		to:   to,
-	}
-	if !b.Disable {
-		t := b.extract()
-		s.style = &t
+	return section{
+		from:  from,
+		to:    to,
+		style: b.styling(),
 	}
-	return s
 }
 
 func (p Painted) newSection(from int) section {
@@ -33,13 +31,6 @@ func (p Painted) newSection(from int) section {
 	return s
 }
 
-func (s section) evaluateOn(content string) string {
-	if s.style == nil {
-		return content[s.from:s.to]
-	}
-	return s.apply(content[s.from:s.to])
-}
-
 func (s *section) shift(offset int) {
 	s.from += offset
 	s.to += offset
@@ -52,6 +43,7 @@ type Highlighted struct {
 	sectors []section
 	content string
 	disable bool
+	minimal bool
 }
 
 // Join different values together into a single item maintaining all styling
@@ -62,6 +54,10 @@ func Join(values ...any) (joined Highlighted) {
 
 // Highlight only the matching part of the given string with the color of the brush
 func (b Brush[color]) Highlight(s string, find *regexp.Regexp) Highlighted {
+	if b.settings().Sanitize {
+		// the matches are searched on the original string and then each part is sanitized
+		return b.HighlightFunc(s, find, func(match string) string { return match })
+	}
 	var result = Highlighted{content: s, disable: b.Disable}
 	if result.disable {
 		return result
@@ -83,37 +79,51 @@ func (b Brush[color]) Highlight(s string, find *regexp.Regexp) Highlighted {
 // HighlightFunc is like Highlight but allows you to replace the part that will match
 // the returned string of the repl function will then be highlighted using brush styling
 func (b Brush[color]) HighlightFunc(s string, find *regexp.Regexp, repl func(string) string) Highlighted {
-	var result = Highlighted{disable: b.Disable}
+	var (
+		result   = Highlighted{disable: b.Disable}
+		sanitize = b.settings().Sanitize
+		clean    = func(text string) string {
+			if sanitize {
+				return Sanitize(text)
+			}
+			return text
+		}
+	)
 
 	found := find.FindAllStringIndex(s, -1)
 	if found == nil {
-		result.content = s
+		result.content = clean(s)
 		return result
 	}
 
-	var from, to int
+	var (
+		from, to int
+		content  strings.Builder
+	)
+	content.Grow(len(s))
 
 	for _, indexes := range found {
 		from = indexes[0]
 		if to < indexes[1] {
-			result.content += s[to:from]
+			content.WriteString(clean(s[to:from]))
 		}
 		to = indexes[1]
 
-		replacement := repl(s[from:to])
+		replacement := clean(repl(s[from:to]))
 		if replSize := len(replacement); replSize > 0 {
-			size := len(result.content)
+			size := content.Len()
 			result.sectors = append(result.sectors, b.newSection(
 				size,
 				size+replSize,
 			))
-			result.content += replacement
+			content.WriteString(replacement)
 		}
 	}
 
 	if to < len(s) {
-		result.content += s[to:]
+		content.WriteString(clean(s[to:]))
 	}
+	result.content = content.String()
 
 	return result
 }
@@ -123,54 +133,63 @@ func (b Brush[color]) HighlightFunc(s string, find *regexp.Regexp, repl func(str
 // Highlighted items will maintain their styling only on the subset that contains info about it,
 // for other values (and the subset of Highlighted items that do not specify info) the brush style will be enforced
 func (b Brush[color]) Embed(values ...any) Highlighted {
-	var res = Highlighted{disable: b.Disable}
+	return embed(b.styling(), b.Disable, b.settings().Sanitize, values)
+}
+
+// embed joins the values using the given style for the parts that do not specify one,
+// sanitizing the values that are not Painted or Highlighted items if required
+func embed(s *style, disable, sanitize bool, values []any) Highlighted {
+	var (
+		res     = Highlighted{disable: disable}
+		content strings.Builder
+	)
+	content.Grow(contentSize(values))
 
 	for _, rawValue := range values {
-		size := len(res.content)
+		size := content.Len()
 
 		switch v := rawValue.(type) {
 		case Painted:
 			res.addSections(v.newSection(size))
-			res.content += v.content
+			content.WriteString(v.content)
+		case *Painted:
+			res.addSections(v.newSection(size))
+			content.WriteString(v.content)
 		case *Highlighted:
-			b.embedHightlight(&res, *v)
+			embedHightlight(&res, *v, size, s)
+			content.WriteString(v.content)
 		case Highlighted:
-			b.embedHightlight(&res, v)
-		case string:
-			res.addSections(b.newSection(size, size+len(v)))
-			res.content += v
-		case fmt.Stringer:
-			s := v.String()
-			res.addSections(b.newSection(size, size+len(s)))
-			res.content += s
+			embedHightlight(&res, v, size, s)
+			content.WriteString(v.content)
 		default:
-			s := fmt.Sprint(v)
-			res.addSections(b.newSection(size, size+len(s)))
-			res.content += s
+			str := contentOf(v, sanitize)
+			res.addSections(section{size, size + len(str), s})
+			content.WriteString(str)
 		}
 
 	}
+	res.content = content.String()
 
 	return res
 }
 
-func (b Brush[color]) embedHightlight(res *Highlighted, v Highlighted) {
+// embedHightlight adds the sections of v placed at the given offset, using s for the parts that do not specify one
+func embedHightlight(res *Highlighted, v Highlighted, offset int, s *style) {
 	var (
-		size = len((*res).content)
-		last = size
+		last = offset
+		end  = offset + len(v.content)
 	)
 
 	for _, sec := range v.sectors {
-		sec.shift(size)
+		sec.shift(offset)
 		if last < sec.from {
-			res.addSections(b.newSection(last, sec.from))
+			res.addSections(section{last, sec.from, s})
 		}
 		res.addSections(sec)
 		last = sec.to
 	}
-	res.content += v.content
-	if size = len(res.content); last < size {
-		res.addSections(b.newSection(last, size))
+	if last < end {
+		res.addSections(section{last, end, s})
 	}
 }
 
@@ -178,53 +197,110 @@ func (h *Highlighted) addSections(s ...section) {
 	h.sectors = append(h.sectors, s...)
 }
 
-// Append lets you add some items at the end of the Highlighted content
+// contentSize gives the size of the content of the values that is known without converting them
+func contentSize(values []any) (size int) {
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			size += len(v)
+		case Painted:
+			size += len(v.content)
+		case *Painted:
+			size += len(v.content)
+		case Highlighted:
+			size += len(v.content)
+		case *Highlighted:
+			size += len(v.content)
+		}
+	}
+	return
+}
+
+// Append lets you add some items at the end of the Highlighted content.
+// Appending many items with a single call is faster than using multiple calls
 func (h *Highlighted) Append(values ...any) *Highlighted {
+	if len(values) == 0 {
+		return h
+	}
+
+	var content strings.Builder
+	content.Grow(len(h.content) + contentSize(values))
+	content.WriteString(h.content)
+
 	for i := range values {
 		switch v := values[i].(type) {
 		case Painted:
-			h.addSections(v.newSection(len(h.content)))
-			h.content += v.content
+			h.addSections(v.newSection(content.Len()))
+			content.WriteString(v.content)
+		case *Painted:
+			h.addSections(v.newSection(content.Len()))
+			content.WriteString(v.content)
 		case *Highlighted:
-			h.append(*v)
+			h.appendSections(*v, content.Len())
+			content.WriteString(v.content)
 		case Highlighted:
-			h.append(v)
+			h.appendSections(v, content.Len())
+			content.WriteString(v.content)
 		default:
-			h.content += fmt.Sprint(v)
+			content.WriteString(fmt.Sprint(v))
 		}
 	}
+	h.content = content.String()
 
 	return h
 }
 
-func (h *Highlighted) append(v Highlighted) {
-	size := len(h.content)
-
+// appendSections adds the sections of v placed at the given offset
+func (h *Highlighted) appendSections(v Highlighted, offset int) {
 	for _, sec := range v.sectors {
-		sec.shift(size)
+		sec.shift(offset)
 		h.addSections(sec)
 	}
-	h.content += v.content
+}
+
+// Disabled tells if the styling of the highlighted item is disabled, meaning that it's shown as plain text
+func (h Highlighted) Disabled() bool {
+	return h.disable
 }
 
 // String evaluates the content by applying the different styling where specified
 func (h Highlighted) String() string {
-	var (
-		res  string
-		last int
-	)
+	return bytesToString(h.AppendTo(make([]byte, 0, h.size())))
+}
+
+// AppendTo appends to dst the content with the styling applied (like String) and gives back the extended buffer
+func (h Highlighted) AppendTo(dst []byte) []byte {
+	if h.minimal {
+		return h.appendMinimal(dst)
+	}
 
+	var last int
 	for _, sec := range h.sectors {
 		if last < sec.from {
-			res += h.content[last:sec.from]
+			dst = append(dst, h.content[last:sec.from]...)
+		}
+		if sec.style == nil {
+			dst = append(dst, h.content[sec.from:sec.to]...)
+		} else {
+			dst = sec.appendTo(dst, h.content[sec.from:sec.to])
 		}
-		res += sec.evaluateOn(h.content)
 		last = sec.to
 	}
 
 	if last < len(h.content) {
-		res += h.content[last:]
+		dst = append(dst, h.content[last:]...)
 	}
+	return dst
+}
 
-	return res
+// WriteTo writes on w the content with the styling applied (like String)
+func (h Highlighted) WriteTo(w io.Writer) (int64, error) {
+	buf := bufferPool.Get().(*[]byte)
+	*buf = h.AppendTo((*buf)[:0])
+	return writeBuffer(w, buf)
+}
+
+// size estimates the length of the rendered content
+func (h Highlighted) size() int {
+	return len(h.content) + len(h.sectors)*sequencesSize
 }
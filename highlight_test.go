@@ -146,6 +146,9 @@ func TestHighlighted_Append(t *testing.T) {
 		).String(),
 		"trash[31mred[0mgarbagecoolc[31mi[0m[31ma[0m[31mo[0m[32mgreen[0m3trash [31mred banana[0m trash",
 	)
+
+	green := brush.Paint(brush.Green, nil, "green")
+	assert(t, `Append of painted pointer`, brush.Join("a ", &green).Markup(), "a [green]green[/]")
 }
 
 func TestBrush_Embed(t *testing.T) {
@@ -167,7 +170,7 @@ func TestBrush_Embed(t *testing.T) {
 	isYellow := brush.Join(" is ", marker.Paint("yellow"))
 	assert(t, `Embedding: "The banana is yellow"`,
 		myBrush.Embed("The ", &banana, isYellow).String(),
-		"[31mThe [0m[31m[30;43mbanana[0m[0m[31m is [0m[30;43myellow[0m",
+		"[31mThe [0m[30;43mbanana[0m[31m is [0m[30;43myellow[0m",
 	)
 
 	text := "A fox jumps over the lazy dog"
@@ -0,0 +1,155 @@
+package brush
+
+import (
+	"image"
+	"strings"
+)
+
+// RenderImage draws the image using half blocks ("▀"), so that each cell of the terminal shows two pixels:
+// the top one with the font color and the bottom one with the background color.
+// The image is scaled to fit the given number of columns and rows (each row holds two pixels),
+// if one of them is zero it's computed to keep the aspect ratio.
+// With ProfileANSI and ProfileExtended the colors are dithered to make up for the smaller set of colors,
+// translucent pixels are composited over the Backdrop. If the styling is disabled the result has no colors.
+// The palette of the Default renderer is used, see the RenderImage method of Renderer to use another one
+func RenderImage(img image.Image, columns, rows int, profile Profile) Highlighted {
+	r := defaults()
+	return renderImage(img, columns, rows, profile, r.palette(), r.Disable)
+}
+
+func renderImage(img image.Image, columns, rows int, profile Profile, palette Palette, disable bool) Highlighted {
+	var (
+		bounds = img.Bounds()
+		res    = Highlighted{disable: disable}
+	)
+	if bounds.Empty() || columns <= 0 && rows <= 0 {
+		return res
+	}
+
+	switch {
+	case columns <= 0:
+		columns = max(1, (bounds.Dx()*rows*2+bounds.Dy()/2)/bounds.Dy())
+	case rows <= 0:
+		rows = max(1, (bounds.Dy()*columns/bounds.Dx()+1)/2)
+	}
+
+	pixels := scaleImage(img, columns, rows*2)
+	if profile != ProfileTrueColor {
+		pixels = dither(pixels, profile, palette)
+	}
+
+	var content strings.Builder
+	for y := 0; y < len(pixels); y += 2 {
+		if y > 0 {
+			content.WriteByte('\n')
+		}
+
+		var last *section
+		for x := range pixels[y] {
+			var s = style{foreground: pixels[y][x].foreground()}
+			if y+1 < len(pixels) {
+				s.background = pixels[y+1][x].background()
+			}
+
+			from := content.Len()
+			content.WriteString("▀")
+			if res.disable {
+				continue
+			}
+			// adjacent cells with the same colors are merged in a single section
+			if last != nil && *last.style == s {
+				last.to = content.Len()
+				continue
+			}
+			res.addSections(section{from: from, to: content.Len(), style: &s})
+			last = &res.sectors[len(res.sectors)-1]
+		}
+	}
+	res.content = content.String()
+
+	return res
+}
+
+// scaleImage resizes the image to the given size, averaging the pixels that end up in the same one
+func scaleImage(img image.Image, width, height int) [][]Color {
+	var (
+		bounds = img.Bounds()
+		pixels = make([][]Color, height)
+	)
+
+	for y := range pixels {
+		pixels[y] = make([]Color, width)
+		fromY, toY := bounds.Min.Y+y*bounds.Dy()/height, bounds.Min.Y+(y+1)*bounds.Dy()/height
+		for x := range pixels[y] {
+			fromX, toX := bounds.Min.X+x*bounds.Dx()/width, bounds.Min.X+(x+1)*bounds.Dx()/width
+
+			var r, g, b, a, count uint64
+			for sy := fromY; sy < max(toY, fromY+1); sy++ {
+				for sx := fromX; sx < max(toX, fromX+1); sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a, count = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa), count+1
+				}
+			}
+
+			// RGBA gives premultiplied 16 bit components
+			var c TrueColor
+			if a > 0 {
+				c = TrueColor{uint8(r * 255 / a), uint8(g * 255 / a), uint8(b * 255 / a)}
+			}
+			pixels[y][x] = ToColor(AlphaColor{c.Red, c.Green, c.Blue, uint8(a / count >> 8)})
+		}
+	}
+
+	return pixels
+}
+
+// dither converts the pixels to the colors of the profile (and the palette for the ANSIColor)
+// using the Floyd-Steinberg error diffusion
+func dither(pixels [][]Color, profile Profile, palette Palette) [][]Color {
+	var (
+		width  = len(pixels[0])
+		errors = make([][][3]int, len(pixels))
+		res    = make([][]Color, len(pixels))
+	)
+	for y := range errors {
+		errors[y] = make([][3]int, width)
+	}
+
+	spread := func(x, y int, diff [3]int, weight int) {
+		if x < 0 || x >= width || y >= len(pixels) {
+			return
+		}
+		for i := range diff {
+			errors[y][x][i] += diff[i] * weight / 16
+		}
+	}
+
+	for y := range pixels {
+		res[y] = make([]Color, width)
+		for x := range pixels[y] {
+			var (
+				original = pixels[y][x].ToTrueColor()
+				wanted   = [3]int{
+					int(original.Red) + errors[y][x][0],
+					int(original.Green) + errors[y][x][1],
+					int(original.Blue) + errors[y][x][2],
+				}
+				adjusted = TrueColor{clampComponent(wanted[0]), clampComponent(wanted[1]), clampComponent(wanted[2])}
+				c, got   = profile.convert(adjusted, palette)
+				diff     = [3]int{wanted[0] - int(got.Red), wanted[1] - int(got.Green), wanted[2] - int(got.Blue)}
+			)
+
+			res[y][x] = c
+			spread(x+1, y, diff, 7)
+			spread(x-1, y+1, diff, 3)
+			spread(x, y+1, diff, 5)
+			spread(x+1, y+1, diff, 1)
+		}
+	}
+
+	return res
+}
+
+func clampComponent(n int) uint8 {
+	return uint8(min(255, max(0, n)))
+}
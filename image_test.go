@@ -0,0 +1,102 @@
+package brush_test
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleRenderImage() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 4; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 85), B: uint8(y * 85), A: 255})
+		}
+	}
+
+	thumbnail := brush.RenderImage(img, 2, 0, brush.ProfileTrueColor)
+	fmt.Printf("%q\n", thumbnail.String())
+	// Output: "\x1b[38;2;42;0;42;48;2;42;0;212m▀\x1b[0m\x1b[38;2;212;0;42;48;2;212;0;212m▀\x1b[0m"
+}
+
+func ExampleTrueColor_ToExtended() {
+	orange := brush.TrueColor{Red: 255, Green: 165}
+
+	fmt.Println(orange.ToExtended(), orange.ToANSI() == brush.BrightYellow)
+	// Output: 214 true
+}
+
+/* ---[ TESTS ]--- */
+
+func TestRenderImage(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for x := 0; x < 2; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+		img.Set(x, 1, color.RGBA{B: 255, A: 255})
+	}
+
+	var cases = []struct {
+		profile  brush.Profile
+		expected string
+	}{
+		{brush.ProfileTrueColor, "\x1b[38;2;255;0;0;48;2;0;0;255m▀▀\x1b[0m"},
+		{brush.ProfileExtended, "\x1b[38;5;196;48;5;21m▀▀\x1b[0m"},
+		{brush.ProfileANSI, "\x1b[91;104m▀▀\x1b[0m"},
+	}
+
+	for _, c := range cases {
+		if got := brush.RenderImage(img, 2, 1, c.profile).String(); got != c.expected {
+			t.Errorf("Profile %s | want: %q got: %q", c.profile, c.expected, got)
+		}
+	}
+
+	if got := brush.Width(brush.RenderImage(img, 0, 3, brush.ProfileTrueColor)); got != 6 {
+		t.Errorf("Expected width 6 keeping the aspect ratio, got %d", got)
+	}
+}
+
+func TestTrueColor_ToExtended(t *testing.T) {
+	for i := 16; i < 256; i++ {
+		if i == 232 {
+			continue // same color as black
+		}
+		c := brush.ExtendedANSIColor(i)
+		if got := c.ToTrueColor().ToExtended(); got != c {
+			t.Errorf("Expected %d to be converted back to itself, got %d", i, got)
+		}
+	}
+
+	for ansi := brush.Black; ansi <= brush.BrightWhite; ansi++ {
+		if got := ansi.ToTrueColor().ToANSI(); got != ansi {
+			t.Errorf("Expected %v to be converted back to itself, got %v", ansi, got)
+		}
+	}
+}
+
+func TestDetectProfile(t *testing.T) {
+	var cases = []struct {
+		colorterm, term string
+		expected        brush.Profile
+	}{
+		{"truecolor", "xterm-256color", brush.ProfileTrueColor},
+		{"", "xterm-256color", brush.ProfileExtended},
+		{"", "xterm", brush.ProfileANSI},
+	}
+
+	for _, c := range cases {
+		t.Setenv("COLORTERM", c.colorterm)
+		t.Setenv("TERM", c.term)
+		if got := brush.DetectProfile(); got != c.expected {
+			t.Errorf("COLORTERM=%q TERM=%q | want: %s got: %s", c.colorterm, c.term, c.expected, got)
+		}
+	}
+}
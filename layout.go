@@ -0,0 +1,216 @@
+package brush
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// runeWidth gives the number of cells that the rune occupies on the terminal
+func runeWidth(r rune) int {
+	switch {
+	case r < 32 || r >= 0x7f && r < 0xa0:
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r >= 0x1100 && r <= 0x115f, r >= 0x2e80 && r <= 0x303e, r >= 0x3041 && r <= 0x33ff,
+		r >= 0x3400 && r <= 0x4dbf, r >= 0x4e00 && r <= 0x9fff, r >= 0xa000 && r <= 0xa4cf,
+		r >= 0xac00 && r <= 0xd7a3, r >= 0xf900 && r <= 0xfaff, r >= 0xfe30 && r <= 0xfe4f,
+		r >= 0xff00 && r <= 0xff60, r >= 0xffe0 && r <= 0xffe6, r >= 0x1f300 && r <= 0x1f64f,
+		r >= 0x1f900 && r <= 0x1f9ff, r >= 0x20000 && r <= 0x3fffd:
+		return 2
+	}
+	return 1
+}
+
+// textWidth gives the number of cells that the string occupies on the terminal
+func textWidth(s string) (width int) {
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return
+}
+
+// Width gives the number of cells that a value (Painted, Highlighted or anything else)
+// occupies on the terminal, ignoring the styling. For multi-line values the widest line is considered
+func Width(value any) (width int) {
+	for _, line := range strings.Split(extractContent(value), "\n") {
+		width = max(width, textWidth(line))
+	}
+	return
+}
+
+// Plain gives the content of a value (Painted, Highlighted or anything else) without any styling
+func Plain(value any) string {
+	return extractContent(value)
+}
+
+// toHighlighted converts any value into an Highlighted item
+func toHighlighted(value any) Highlighted {
+	switch v := value.(type) {
+	case Highlighted:
+		return v
+	case *Highlighted:
+		return *v
+	case *Painted:
+		return Join(*v)
+	}
+	return Join(value)
+}
+
+// slice gives the part of the highlighted item between the given byte offsets keeping the styling
+func (h Highlighted) slice(from, to int) Highlighted {
+	var res = Highlighted{content: h.content[from:to], disable: h.disable}
+
+	for _, sec := range h.sectors {
+		if sec.to <= from || sec.from >= to {
+			continue
+		}
+		sec.from, sec.to = max(sec.from, from), min(sec.to, to)
+		sec.shift(-from)
+		res.addSections(sec)
+	}
+
+	return res
+}
+
+// lines splits the highlighted item on each new line
+func (h Highlighted) lines() (lines []Highlighted) {
+	var from int
+	for i := strings.IndexByte(h.content, '\n'); i >= 0; i = strings.IndexByte(h.content[from:], '\n') {
+		lines = append(lines, h.slice(from, from+i))
+		from += i + 1
+	}
+
+	return append(lines, h.slice(from, len(h.content)))
+}
+
+// truncate cuts the (single line) highlighted item so that it does not exceed the given width,
+// the last visible character is replaced by "…" if something is cut
+func (h Highlighted) truncate(width int) Highlighted {
+	if textWidth(h.content) <= width {
+		return h
+	} else if width <= 0 {
+		return h.slice(0, 0)
+	}
+
+	var used int
+	for i, r := range h.content {
+		if used+runeWidth(r) > width-1 {
+			res := h.slice(0, i)
+			res.Append("…")
+			if sec := h.sectionAt(i); sec != nil {
+				res.addSections(section{from: i, to: len(res.content), style: sec.style})
+			}
+			return res
+		}
+		used += runeWidth(r)
+	}
+
+	return h
+}
+
+// wrap splits the (single line) highlighted item on multiple lines that do not exceed the given width,
+// breaking on spaces when possible
+func (h Highlighted) wrap(width int) (lines []Highlighted) {
+	if width <= 0 || textWidth(h.content) <= width {
+		return []Highlighted{h}
+	}
+
+	var start, used, space = 0, 0, -1
+	for i := 0; i < len(h.content); {
+		r, size := utf8.DecodeRuneInString(h.content[i:])
+		if r == ' ' {
+			space = i
+		}
+
+		if w := runeWidth(r); used+w <= width || i == start {
+			used += w
+			i += size
+			continue
+		}
+
+		end, next := i, i
+		if space > start {
+			end, next = space, space+1
+		}
+		lines = append(lines, h.slice(start, end))
+		start, used, space, i = next, 0, -1, next
+	}
+
+	return append(lines, h.slice(start, len(h.content)))
+}
+
+// sectionAt gives the section that contains the given byte offset, nil if none
+func (h Highlighted) sectionAt(offset int) *section {
+	for i := range h.sectors {
+		if h.sectors[i].from <= offset && offset < h.sectors[i].to {
+			return &h.sectors[i]
+		}
+	}
+	return nil
+}
+
+// layer gives back the highlighted item with the given style placed below the existing ones:
+// the parts without styling will use it and the others will be merged on top of it
+func (h Highlighted) layer(s *style) Highlighted {
+	if s == nil {
+		return h
+	}
+
+	var (
+		res  = Highlighted{content: h.content, disable: h.disable}
+		last int
+	)
+	for _, sec := range h.sectors {
+		if last < sec.from {
+			res.addSections(section{from: last, to: sec.from, style: s})
+		}
+		if sec.style == nil {
+			sec.style = s
+		} else {
+			merged := s.merge(*sec.style)
+			sec.style = &merged
+		}
+		res.addSections(sec)
+		last = sec.to
+	}
+	if last < len(h.content) {
+		res.addSections(section{from: last, to: len(h.content), style: s})
+	}
+
+	return res
+}
+
+// Alignment represents the horizontal position of some content inside a wider space
+type Alignment uint8
+
+const (
+	AlignLeft   Alignment = iota // AlignLeft puts the content on the left side
+	AlignRight                   // AlignRight puts the content on the right side
+	AlignCenter                  // AlignCenter puts the content in the middle
+)
+
+// pad fills with spaces the (single line) highlighted item until reaching the given width
+func (h Highlighted) pad(width int, align Alignment) Highlighted {
+	var (
+		missing     = max(0, width-textWidth(h.content))
+		left, right int
+	)
+
+	switch align {
+	case AlignRight:
+		left = missing
+	case AlignCenter:
+		left = missing / 2
+		right = missing - left
+	default:
+		right = missing
+	}
+
+	if left == 0 && right == 0 {
+		return h
+	}
+
+	return Join(strings.Repeat(" ", left), h, strings.Repeat(" ", right))
+}
@@ -0,0 +1,80 @@
+package brush
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Link makes the painted item a clickable hyperlink to the given url on the terminals that support it (OSC 8).
+// The id is optional: parts of text with the same url and id are treated by the terminal as a single link
+// even if they are not adjacent. When the painted item is disabled the link is ignored.
+// Control characters are removed from url and id so that they cannot inject other sequences
+func (p *Painted) Link(url, id string) *Painted {
+	p.link, p.linkID = safeLink(url, id)
+	return p
+}
+
+// safeLink removes from the url and the id of a link the characters that would end the OSC 8 sequence early
+// (like BEL and ESC) and, from the id, the ones that separate its parameters
+func safeLink(url, id string) (string, string) {
+	url = strings.Map(func(r rune) rune {
+		if r < 0x20 || r >= 0x7f && r <= 0x9f {
+			return -1
+		}
+		return r
+	}, url)
+	id = strings.Map(func(r rune) rune {
+		if r < 0x20 || r >= 0x7f && r <= 0x9f || r == ':' || r == ';' {
+			return -1
+		}
+		return r
+	}, id)
+	return url, id
+}
+
+// Link makes all the content of the highlighted item a clickable hyperlink to the given url,
+// see the Link method of Painted for more info. The link is ignored if the styling is disabled
+// for the highlighted item or for the Default renderer
+func (h *Highlighted) Link(url, id string) *Highlighted {
+	if h.disable || disabled() {
+		return h
+	}
+
+	url, id = safeLink(url, id)
+	linked := h.layer(&style{}).sectors
+	for i := range linked {
+		s := *linked[i].style
+		s.link, s.linkID = url, id
+		linked[i].style = &s
+	}
+	h.sectors = linked
+
+	return h
+}
+
+// LinkFunc makes a clickable hyperlink each part of the highlighted item that matches with find,
+// the url of the link is the one given by the url function that receives the matching text.
+// If the url function returns an empty string the match will not be a link. Like Link, it does nothing if the styling is disabled
+func (h *Highlighted) LinkFunc(find *regexp.Regexp, url func(match string) string) *Highlighted {
+	if h.disable || disabled() {
+		return h
+	}
+
+	var (
+		parts []any
+		last  int
+	)
+	for _, indexes := range find.FindAllStringIndex(h.content, -1) {
+		match := h.slice(indexes[0], indexes[1])
+		if link := url(match.content); link != "" {
+			match.Link(link, "")
+		}
+		parts = append(parts, h.slice(last, indexes[0]), match)
+		last = indexes[1]
+	}
+	res := Join(append(parts, h.slice(last, len(h.content)))...)
+	res.disable = h.disable
+	*h = res
+
+	return h
+}
@@ -0,0 +1,125 @@
+package brush_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExamplePainted_Link() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	path := brush.Paint(brush.Cyan, nil, "main.go")
+	path.Link("file:///home/user/main.go", "")
+
+	fmt.Printf("%q\n", path.String())
+	// Output: "\x1b]8;;file:///home/user/main.go\x1b\\\x1b[36mmain.go\x1b[0m\x1b]8;;\x1b\\"
+}
+
+func ExampleHighlighted_LinkFunc() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	text := brush.Join("fixed in #42")
+	text.LinkFunc(regexp.MustCompile(`#\d+`), func(issue string) string {
+		return "https://github.com/DazFather/brush/issues/" + issue[1:]
+	})
+
+	fmt.Printf("%q\n", text.String())
+	// Output: "fixed in \x1b]8;;https://github.com/DazFather/brush/issues/42\x1b\\#42\x1b]8;;\x1b\\"
+}
+
+/* ---[ TESTS ]--- */
+
+func TestLink(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	const (
+		open  = "\x1b]8;id=7;https://example.com\x1b\\"
+		close = "\x1b]8;;\x1b\\"
+	)
+	link := brush.Paint(brush.Red, nil, "site")
+	link.Link("https://example.com", "7")
+	expected := open + "\x1b[31msite\x1b[0m" + close
+
+	if got := link.String(); got != expected {
+		t.Errorf("Painted link | want: %q got: %q", expected, got)
+	}
+
+	if got := brush.Join("visit ", link).String(); got != "visit "+expected {
+		t.Errorf("Joined link | want: %q got: %q", "visit "+expected, got)
+	}
+
+	embedded := brush.New(brush.Blue, nil).Embed(link, "!")
+	if got, want := embedded.String(), expected+"\x1b[34m!\x1b[0m"; got != want {
+		t.Errorf("Embedded link | want: %q got: %q", want, got)
+	}
+
+	var appended brush.Highlighted
+	appended.Append("go to ", link)
+	if got, want := appended.String(), "go to "+expected; got != want {
+		t.Errorf("Appended link | want: %q got: %q", want, got)
+	}
+
+	whole := brush.Join("a ", brush.Paint(brush.Red, nil, "b"))
+	whole.Link("https://example.com", "7")
+	if got, want := whole.String(), open+"a "+close+open+"\x1b[31mb\x1b[0m"+close; got != want {
+		t.Errorf("Highlighted link | want: %q got: %q", want, got)
+	}
+
+	if got, want := whole.Markup(), "[link=https://example.com id=7]a [/][red link=https://example.com id=7]b[/]"; got != want {
+		t.Errorf("Markup | want: %q got: %q", want, got)
+	}
+	if parsed := brush.MustMarkup(whole.Markup()); parsed.String() != whole.String() {
+		t.Errorf("Markup round trip | want: %q got: %q", whole.String(), parsed.String())
+	}
+
+	defer func(previous bool) { brush.Disable = previous }(brush.Disable)
+	brush.Disable = true
+	disabled := brush.Paint(brush.Red, nil, "site")
+	disabled.Link("https://example.com", "")
+	if got := brush.Join(disabled).String(); got != "site" {
+		t.Errorf("Disabled link | want: %q got: %q", "site", got)
+	}
+	joined := brush.Join("file.go")
+	if got := joined.Link("https://example.com", "").String(); got != "file.go" {
+		t.Errorf("Disabled highlighted link | want: %q got: %q", "file.go", got)
+	}
+	joined = brush.Join("see file.go")
+	if got := joined.LinkFunc(regexp.MustCompile(`\S+\.go`), func(m string) string { return "file://" + m }).String(); got != "see file.go" {
+		t.Errorf("Disabled LinkFunc | want: %q got: %q", "see file.go", got)
+	}
+}
+
+func TestStyle_WithLink(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		linked   = brush.NewStyle(brush.Red, nil).WithLink("https://example.com", "")
+		expected = "\x1b]8;;https://example.com\x1b\\\x1b[31msite\x1b[0m\x1b]8;;\x1b\\"
+		renderer = &brush.Renderer{Profile: brush.ProfileTrueColor}
+		b        = brush.New(brush.Red, nil)
+	)
+
+	if got := linked.Paint("site").String(); got != expected {
+		t.Errorf("Style.Paint | want: %q got: %q", expected, got)
+	}
+	if got := linked.Brush().Paint("site").String(); got != expected {
+		t.Errorf("Style.Brush | want: %q got: %q", expected, got)
+	}
+	if got := renderer.New(linked).Paint("site").String(); got != expected {
+		t.Errorf("Renderer.New | want: %q got: %q", expected, got)
+	}
+	if got := renderer.Highlight(linked, "site", regexp.MustCompile("site")).String(); got != expected {
+		t.Errorf("Renderer.Highlight | want: %q got: %q", expected, got)
+	}
+	if got := b.UseLink("https://example.com", "").Paint("site").String(); got != expected {
+		t.Errorf("Brush.UseLink | want: %q got: %q", expected, got)
+	}
+	if b.Style() != linked {
+		t.Errorf("Brush.Style | want: %s got: %s", linked, b.Style())
+	}
+}
@@ -0,0 +1,126 @@
+package brush
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// Live is a multi-line region of the terminal that shows the last frame given to Update,
+// redrawing in place only the lines that changed. It's safe to use from multiple goroutines.
+// Lines longer than the width of the terminal are truncated, so that they can be redrawn precisely.
+// If the Output is not interactive (see Disable and DisableIfNotTTY) nothing is drawn until Stop,
+// that writes the last frame
+type Live struct {
+	Output io.Writer // Output is where the region will be written, os.Stdout if nil
+
+	last  Highlighted
+	drawn []string
+	width int
+	mu    sync.Mutex
+}
+
+// NewLive creates a new live region that will be written on the given output
+func NewLive(output io.Writer) *Live {
+	return &Live{Output: output}
+}
+
+func (l *Live) output() io.Writer {
+	if l.Output == nil {
+		return os.Stdout
+	}
+	return l.Output
+}
+
+// Update replaces the content of the region with the given frame
+func (l *Live) Update(frame Highlighted) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.last = frame
+	if interactive(l.output()) {
+		l.redraw()
+	}
+}
+
+// Stop ends the live region leaving the last frame on the terminal,
+// if the output is not interactive the last frame is written now
+func (l *Live) Stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !interactive(l.output()) {
+		fmt.Fprintln(l.output(), l.last)
+	}
+	l.drawn = nil
+}
+
+// terminalWidth gives the number of columns of the output, 0 if unknown
+func (l *Live) terminalWidth() int {
+	if f, ok := l.output().(*os.File); ok {
+		if width, _, err := term.GetSize(int(f.Fd())); err == nil {
+			return width
+		}
+	}
+	return 0
+}
+
+// redraw writes the lines of the last frame that are different from the drawn ones.
+// The cursor is always left on the line after the region
+func (l *Live) redraw() {
+	var (
+		lines    = l.last.lines()
+		width    = l.terminalWidth()
+		rendered = make([]string, len(lines))
+		out      strings.Builder
+		skip     int
+	)
+
+	for i, line := range lines {
+		if width > 0 {
+			line = line.truncate(width)
+		}
+		rendered[i] = line.String()
+	}
+	if width == l.width && slices.Equal(rendered, l.drawn) {
+		return
+	}
+
+	// after a resize the terminal might have reflowed the old lines, so everything is drawn again
+	if width != l.width {
+		if len(l.drawn) > 0 {
+			out.WriteString(CursorPreviousLine(len(l.drawn)).sequence + ClearScreenDown().sequence)
+		}
+		l.drawn, l.width = nil, width
+	} else if len(l.drawn) > 0 {
+		out.WriteString(CursorPreviousLine(len(l.drawn)).sequence)
+	}
+
+	for i, line := range rendered {
+		if i < len(l.drawn) && l.drawn[i] == line {
+			skip++
+			continue
+		}
+		if skip > 0 {
+			out.WriteString(CursorNextLine(skip).sequence)
+			skip = 0
+		}
+		out.WriteString("\r" + line + ClearLineRight().sequence + "\n")
+	}
+	if skip > 0 {
+		out.WriteString(CursorNextLine(skip).sequence)
+	}
+
+	// the frame shrunk, the remaining old lines are erased
+	if len(rendered) < len(l.drawn) {
+		out.WriteString(ClearScreenDown().sequence)
+	}
+
+	io.WriteString(l.output(), out.String())
+	l.drawn = rendered
+}
@@ -0,0 +1,64 @@
+package brush_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleLive() {
+	brush.DisableIfNotTTY = true // output is not a terminal: only the final frame is written
+	defer func() { brush.DisableIfNotTTY = false }()
+
+	var out strings.Builder
+	live := brush.NewLive(&out)
+	for i := 1; i <= 3; i++ {
+		live.Update(brush.Join(fmt.Sprintf("requests: %d\nerrors: 0", i*10)))
+	}
+	live.Stop()
+
+	fmt.Print(out.String())
+	// Output:
+	// requests: 30
+	// errors: 0
+}
+
+/* ---[ TESTS ]--- */
+
+func TestLive(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		out  strings.Builder
+		live = brush.NewLive(&out)
+	)
+
+	var steps = []struct {
+		frame    string
+		expected string
+	}{
+		{"a\nb\nc", "\ra\x1b[K\n\rb\x1b[K\n\rc\x1b[K\n"},
+		{"a\nB\nc", "\x1b[3F\x1b[1E\rB\x1b[K\n\x1b[1E"},
+		{"a\nB", "\x1b[3F\x1b[2E\x1b[J"},
+		{"a\nB\nc\nd", "\x1b[2F\x1b[2E\rc\x1b[K\n\rd\x1b[K\n"},
+		{"a\nB\nc\nd", ""},
+	}
+
+	for _, step := range steps {
+		out.Reset()
+		live.Update(brush.Join(step.frame))
+		if got := out.String(); got != step.expected {
+			t.Errorf("Frame %q | want: %q got: %q", step.frame, step.expected, got)
+		}
+	}
+
+	out.Reset()
+	live.Stop()
+	if out.Len() != 0 {
+		t.Errorf("Expected nothing written on stop, got: %q", out.String())
+	}
+}
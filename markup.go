@@ -0,0 +1,230 @@
+package brush
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Markup creates an Highlighted item by parsing a string written using a simple markup language, for example:
+//
+//	"[bold red]error[/] in [cyan on black]file.go[/]"
+//
+// A tag contains a space separated list of: attributes (see ParseAttributes), a font color,
+// a background color preceded by "on" (the format of colors is the one of ParseColor),
+// an hyperlink as "link=URL" with an optional "id=ID" (see the Link method of Painted, in both "%", space, brackets
+// and parenthesis must be percent-encoded, for example "%20" for a space),
+// and the names of the roles of the DefaultTheme (use the Markup method of a Theme to use other roles).
+// The style of a tag is applied until "[/]" closes it, tags can be nested and they inherit the style of the outer ones.
+// Use "\[" to write a "[" and "\\" to write a "\"
+func Markup(s string) (Highlighted, error) {
+	return DefaultTheme.Markup(s)
+}
+
+// MustMarkup is like Markup but panics if the string cannot be parsed
+func MustMarkup(s string) Highlighted {
+	h, err := Markup(s)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// Markup is like the Markup function but tags can refer to the roles of the theme
+func (t *Theme) Markup(s string) (h Highlighted, err error) {
+	var (
+		stack []style
+		text  strings.Builder
+		from  int
+	)
+
+	r := defaults()
+	h.disable = r.Disable
+	// flush adds a section for the text written after the previous tag
+	flush := func() {
+		if text.Len() == from {
+			return
+		}
+
+		if len(stack) > 0 && !h.disable {
+			s := r.adapt(stack[len(stack)-1])
+			h.addSections(section{from: from, to: text.Len(), style: &s})
+		}
+		from = text.Len()
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '[' || s[i+1] == '\\') {
+				i++
+			}
+			text.WriteByte(s[i])
+		case '[':
+			size := strings.IndexByte(s[i:], ']')
+			if size < 0 {
+				return Highlighted{}, fmt.Errorf("Cannot parse markup: Unclosed tag at position %d", i)
+			}
+
+			flush()
+			if tag := s[i+1 : i+size]; strings.HasPrefix(tag, "/") {
+				if len(stack) == 0 {
+					return Highlighted{}, fmt.Errorf("Cannot parse markup: Closing tag at position %d without an opening one", i)
+				}
+				stack = stack[:len(stack)-1]
+			} else {
+				tagStyle, err := t.parseTag(tag)
+				if err != nil {
+					return Highlighted{}, err
+				}
+				if len(stack) > 0 {
+					tagStyle = stack[len(stack)-1].merge(tagStyle)
+				}
+				stack = append(stack, tagStyle)
+			}
+			i += size
+		default:
+			text.WriteByte(s[i])
+		}
+	}
+	flush()
+	h.content = text.String()
+
+	return h, nil
+}
+
+func (t *Theme) parseTag(tag string) (s style, err error) {
+	tokens := tagTokens(tag)
+	if len(tokens) == 0 {
+		return s, fmt.Errorf("Cannot parse markup: Empty tag")
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		if strings.EqualFold(token, "on") {
+			if i++; i == len(tokens) {
+				return s, fmt.Errorf("Cannot parse markup tag [%s]: Missing background color after \"on\"", tag)
+			}
+			c, err := ParseColor(tokens[i])
+			if err != nil {
+				return s, fmt.Errorf("Cannot parse markup tag [%s]: %w", tag, err)
+			}
+			s.background = c.background()
+		} else if url, ok := strings.CutPrefix(token, "link="); ok {
+			s.link, _ = safeLink(linkUnescaper.Replace(url), "")
+		} else if id, ok := strings.CutPrefix(token, "id="); ok {
+			_, s.linkID = safeLink("", linkUnescaper.Replace(id))
+		} else if attr, ok := parseAttribute(token); ok {
+			s.attributes |= attr
+		} else if r, ok := t.Role(token); ok {
+			b := r.Brush()
+			s = s.merge(b.extract())
+		} else if c, err := ParseColor(token); err == nil {
+			s.foreground = c.foreground()
+		} else {
+			return s, fmt.Errorf("Cannot parse markup tag [%s]: Unknown %q", tag, token)
+		}
+	}
+
+	return
+}
+
+// tagTokens splits the tag on spaces that are not between parenthesis
+func tagTokens(tag string) (tokens []string) {
+	var from, depth int
+	for i, ch := range tag + " " {
+		switch {
+		case ch == '(':
+			depth++
+		case ch == ')' && depth > 0:
+			depth--
+		case ch == ' ' && depth == 0:
+			if from < i {
+				tokens = append(tokens, tag[from:i])
+			}
+			from = i + 1
+		}
+	}
+
+	return
+}
+
+// linkEscaper percent-encodes the characters of a link that have a meaning inside a tag, linkUnescaper decodes them
+var (
+	linkEscaper   = strings.NewReplacer("%", "%25", " ", "%20", "(", "%28", ")", "%29", "[", "%5B", "]", "%5D")
+	linkUnescaper = strings.NewReplacer("%25", "%", "%20", " ", "%28", "(", "%29", ")", "%5B", "[", "%5b", "[", "%5D", "]", "%5d", "]")
+)
+
+// tag gives the markup tag that represents the style, empty if there is nothing to apply
+func (s style) tag() string {
+	var tokens []string
+	if s.attributes != 0 {
+		tokens = append(tokens, s.attributes.String())
+	}
+	if c, ok := decodeColor(s.foreground); ok {
+		tokens = append(tokens, c.String())
+	}
+	if c, ok := decodeColor(s.background); ok {
+		tokens = append(tokens, "on", c.String())
+	}
+	if s.link != "" {
+		tokens = append(tokens, "link="+linkEscaper.Replace(s.link))
+	}
+	if s.linkID != "" {
+		tokens = append(tokens, "id="+linkEscaper.Replace(s.linkID))
+	}
+
+	if len(tokens) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(tokens, " ") + "]"
+}
+
+func (s style) markup(content string) string {
+	content = escapeMarkup(content)
+	if tag := s.tag(); tag != "" {
+		return tag + content + "[/]"
+	}
+	return content
+}
+
+var markupEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`)
+
+func escapeMarkup(content string) string {
+	return markupEscaper.Replace(content)
+}
+
+// Markup gives back the painted item written in the markup language accepted by the Markup function
+func (p Painted) Markup() string {
+	if p.disable {
+		return escapeMarkup(p.content)
+	}
+	return p.style.markup(p.content)
+}
+
+// Markup gives back the highlighted item written in the markup language accepted by the Markup function.
+// Roles are not preserved, their style is used instead
+func (h Highlighted) Markup() string {
+	var (
+		res  strings.Builder
+		last int
+	)
+
+	for _, sec := range h.sectors {
+		if last < sec.from {
+			res.WriteString(escapeMarkup(h.content[last:sec.from]))
+		}
+		if sec.style == nil {
+			res.WriteString(escapeMarkup(h.content[sec.from:sec.to]))
+		} else {
+			res.WriteString(sec.markup(h.content[sec.from:sec.to]))
+		}
+		last = sec.to
+	}
+
+	if last < len(h.content) {
+		res.WriteString(escapeMarkup(h.content[last:]))
+	}
+
+	return res.String()
+}
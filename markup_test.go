@@ -0,0 +1,111 @@
+package brush_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleMarkup() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	h, err := brush.Markup(`[bold red]error[/] in [cyan on black]file.go[/]: \[[warning]unused[/]]`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(h)
+
+	// Output: [1;31merror[0m in [36;40mfile.go[0m: [[33munused[0m]
+}
+
+func ExampleTheme_Markup() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	theme := brush.NewTheme(nil, map[string]brush.Role{
+		"path": {Foreground: brush.MustParseColor("#5fafff"), Attributes: brush.Underline},
+	})
+
+	h, _ := theme.Markup("open [path]/etc/hosts[/]")
+	fmt.Println(h)
+
+	// Output: open [4;38;2;95;175;255m/etc/hosts[0m
+}
+
+func ExampleHighlighted_Markup() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	h := brush.Join(
+		brush.Paint(brush.Red, brush.UseColor(brush.White), "red"),
+		" [not a tag] ",
+		brush.Paint(brush.ExtendedANSIColor(208), nil, "orange"),
+	)
+	fmt.Println(h.Markup())
+
+	// Output: [red on white]red[/] \[not a tag] [208]orange[/]
+}
+
+/* ---[ TESTS ]--- */
+
+func TestMarkup(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	tests := []struct{ markup, expected string }{
+		{"plain", "plain"},
+		{"", ""},
+		{"[red]a[blue]b[/]c[/]d", "\x1b[31ma\x1b[0m\x1b[34mb\x1b[0m\x1b[31mc\x1b[0md"},
+		{"[red on blue]a[bold]b[on default]c[/][/][/]", "\x1b[31;44ma\x1b[0m\x1b[1;31;44mb\x1b[0m\x1b[1;31;44mc\x1b[0m"},
+		{"[italic]a[underline]b[/][/]", "\x1b[3ma\x1b[0m\x1b[3;4mb\x1b[0m"},
+		{"[rgb(1, 2, 3) on #040506]x[/]", "\x1b[38;2;1;2;3;48;2;4;5;6mx\x1b[0m"},
+		{`\[red]\\\[/`, `[red]\[/`},
+		{`[red]not closed`, "\x1b[31mnot closed\x1b[0m"},
+		{"[success]ok[/]", "\x1b[32mok\x1b[0m"},
+		{"[highlight]x[/]", "\x1b[30;43mx\x1b[0m"},
+		{"[error underline]x[/]", "\x1b[1;4;31mx\x1b[0m"},
+	}
+
+	for _, test := range tests {
+		h, err := brush.Markup(test.markup)
+		if err != nil {
+			t.Errorf("Markup(%q): unexpected error %v", test.markup, err)
+			continue
+		}
+		assert(t, fmt.Sprintf("Markup(%q)", test.markup), h.String(), test.expected)
+	}
+
+	for _, invalid := range []string{"[red", "[/]", "[red]x[/][/]", "[]", "[orange]x", "[red on]x", "[on orange]x"} {
+		if _, err := brush.Markup(invalid); err == nil {
+			t.Errorf("Markup(%q): expected an error", invalid)
+		}
+	}
+}
+
+func TestHighlighted_Markup(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	tests := []string{
+		"plain text",
+		`[bold red]error[/] in [cyan on black]file.go[/]: \[x] \\`,
+		"[italic underline #ff52c5 on 236]fancy[/] and [bright-yellow]bright[/]",
+		"[link=https://example.com/a%20b%5B1%5D%28x%29?q=100%25 id=my%20id]link[/]",
+	}
+
+	for _, markup := range tests {
+		h, err := brush.Markup(markup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert(t, fmt.Sprintf("Round trip of %q", markup), h.Markup(), markup)
+	}
+
+	p := brush.Paint(brush.Green, nil, "[ok]")
+	assert(t, "Painted markup", p.Markup(), `[green]\[ok][/]`)
+
+	p.Link("https://example.com/a b]c%", "")
+	if parsed := brush.MustMarkup(p.Markup()); parsed.String() != brush.Join(p).String() {
+		t.Errorf("Round trip of link | want: %q got: %q", brush.Join(p).String(), parsed.String())
+	}
+}
@@ -0,0 +1,123 @@
+package brush
+
+import "strconv"
+
+// Minimal gives back the highlighted item in minimal mode: when rendered, adjacent parts with the same style
+// are merged and between parts with different styles only the changes are emitted, instead of resetting everything.
+// The output is smaller (especially for gradients or syntax highlighting) but it assumes that no other
+// styling is applied between the parts, so it should not be mixed with other sequences
+func (h Highlighted) Minimal() Highlighted {
+	h.minimal = true
+	return h
+}
+
+func (h Highlighted) appendMinimal(dst []byte) []byte {
+	var (
+		current style
+		last    int
+	)
+	write := func(target style, content string) {
+		if content == "" {
+			return
+		}
+		dst = appendTransition(dst, current, target)
+		dst = append(dst, content...)
+		current = target
+	}
+
+	for _, sec := range h.sectors {
+		if last < sec.from {
+			write(style{}, h.content[last:sec.from])
+		}
+		var target style
+		if sec.style != nil {
+			target = *sec.style
+		}
+		write(target, h.content[sec.from:sec.to])
+		last = sec.to
+	}
+	if last < len(h.content) {
+		write(style{}, h.content[last:])
+	}
+
+	return appendTransition(dst, current, style{})
+}
+
+// appendTransition appends the sequences that change the active style from the first to the second one
+func appendTransition(dst []byte, from, to style) []byte {
+	linkChanged := from.link != to.link || from.linkID != to.linkID
+	if linkChanged && from.link != "" {
+		dst = append(dst, osc+"8;;"+st...)
+	}
+
+	dst = appendSGRDiff(dst, from, to)
+
+	if linkChanged && to.link != "" {
+		dst = append(dst, osc+"8;"...)
+		if to.linkID != "" {
+			dst = append(dst, "id="+to.linkID...)
+		}
+		dst = append(dst, ';')
+		dst = append(dst, to.link+st...)
+	}
+	return dst
+}
+
+// appendSGRDiff appends the SGR sequence that changes only the colors and attributes that differ between the styles
+func appendSGRDiff(dst []byte, from, to style) []byte {
+	if from.foreground == to.foreground && from.background == to.background && from.attributes == to.attributes {
+		return dst
+	}
+	if to.sgr() == "" {
+		return append(dst, csi+colorReset+"m"...)
+	}
+
+	var (
+		start = len(dst)
+		add   = func(code string) {
+			if len(dst) == start {
+				dst = append(dst, csi...)
+			} else {
+				dst = append(dst, ';')
+			}
+			dst = append(dst, code...)
+		}
+		added   = to.attributes &^ from.attributes
+		removed = from.attributes &^ to.attributes
+		offs    uint64 // bitmask of the codes already used to turn off attributes
+	)
+
+	// some attributes share the same code to turn them off (like bold and faint),
+	// the ones that must be kept are enabled again
+	for i, attr := range attributes {
+		if removed.Has(1<<i) && offs&(1<<attr.off) == 0 {
+			offs |= 1 << attr.off
+			add(strconv.Itoa(attr.off))
+		}
+	}
+	for i, attr := range attributes {
+		if to.attributes.Has(1<<i) && offs&(1<<attr.off) != 0 {
+			added |= 1 << i
+		}
+	}
+	if codes := added.codes(); codes != "" {
+		add(codes)
+	}
+
+	if from.foreground != to.foreground {
+		if to.foreground == "" {
+			add("39")
+		} else {
+			add(to.foreground)
+		}
+	}
+	if from.background != to.background {
+		if to.background == "" {
+			add("49")
+		} else {
+			add(to.background)
+		}
+	}
+
+	return append(dst, 'm')
+}
@@ -0,0 +1,68 @@
+package brush_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleHighlighted_Minimal() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	var (
+		keyword = brush.New(brush.Blue, nil)
+		name    = brush.New(brush.Blue, brush.UseColor(brush.Black))
+		text    = brush.Join(keyword.Paint("func"), keyword.Paint(" "), name.Paint("main"), "()")
+	)
+
+	fmt.Printf("%q\n", text.String())
+	fmt.Printf("%q\n", text.Minimal().String())
+	// Output:
+	// "\x1b[34mfunc\x1b[0m\x1b[34m \x1b[0m\x1b[34;40mmain\x1b[0m()"
+	// "\x1b[34mfunc \x1b[40mmain\x1b[0m()"
+}
+
+/* ---[ TESTS ]--- */
+
+func TestHighlighted_Minimal(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	bold := brush.New(brush.Red, nil)
+	bold.UseAttributes(brush.Bold | brush.Faint | brush.Underline)
+	faint := brush.New(brush.Red, nil)
+	faint.UseAttributes(brush.Faint)
+	link := brush.Paint(brush.Red, nil, "c")
+	link.Link("https://example.com", "")
+
+	var cases = []struct {
+		name     string
+		value    brush.Highlighted
+		expected string
+	}{
+		{"plain", brush.Join("plain ", "text"), "plain text"},
+		{
+			"attributes turned off",
+			brush.Join(bold.Paint("a"), faint.Paint("b")),
+			"\x1b[1;2;4;31ma\x1b[22;24;2mb\x1b[0m",
+		},
+		{
+			"default colors",
+			brush.Join(brush.Paint(brush.Red, brush.UseColor(brush.Blue), "a"), brush.Paint(brush.Green, nil, "b")),
+			"\x1b[31;44ma\x1b[32;49mb\x1b[0m",
+		},
+		{
+			"links",
+			brush.Join(brush.Paint(brush.Red, nil, "a"), link, "d"),
+			"\x1b[31ma\x1b]8;;https://example.com\x1b\\c\x1b]8;;\x1b\\\x1b[0md",
+		},
+	}
+
+	for _, c := range cases {
+		if got := c.value.Minimal().String(); got != c.expected {
+			t.Errorf("%s | want: %q got: %q", c.name, c.expected, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package brush
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"golang.org/x/term"
@@ -19,27 +20,25 @@ var (
 	isATTY = term.IsTerminal(int(os.Stdout.Fd()))
 
 	// DisableIfNotTTY disables Painted and Brush if it detects application is
-	// not in a tty at a global level (for this library)
+	// not in a tty at a global level (for this library). It's used by the Default renderer
 	DisableIfNotTTY = true
 
-	// Disable colored output. By default it depends by DisableIfNotTTY
-	Disable = DisableIfNotTTY && !isATTY
+	// Disable colored output. By default it's set if the NO_COLOR environment variable
+	// is not empty (see https://no-color.org), the output is also disabled by DisableIfNotTTY.
+	// It's used by the Default renderer, use SetDefault to change it while other goroutines are painting
+	Disable = os.Getenv("NO_COLOR") != ""
 )
 
 // Paint some values (joined without separator) with the specified font and background color.
 // If a Painted and/or an Highlighted item is given, they will lose their previous style
 // and provided font and background colors will be enforced
 func Paint[color ColorType](font color, background Optional[color], values ...any) Painted {
-	var res = Painted{
-		style:   serialize(font, background),
-		disable: Disable || DisableIfNotTTY && !isATTY,
-	}
-
-	for _, v := range values {
-		res.content += extractContent(v)
+	r := defaults()
+	return Painted{
+		style:   r.adapt(serialize(font, background)),
+		content: joinContent(values, "", r.Sanitize),
+		disable: r.Disable,
 	}
-
-	return res
 }
 
 // Paintln is like Paint but similarly to fmt.Sprintln it separates values with " "
@@ -47,24 +46,12 @@ func Paint[color ColorType](font color, background Optional[color], values ...an
 // If a Painted and/or an Highlighted item is given, they will lose their previous style
 // and provided font and background colors will be enforced
 func Paintln[color ColorType](font color, background Optional[color], values ...any) Painted {
-	const separator = " "
-	var res = Painted{
-		style:   serialize(font, background),
-		disable: Disable || DisableIfNotTTY && !isATTY,
-	}
-
-	if len(values) == 0 {
-		res.content = "\n"
-		return res
-	}
-
-	last := len(values) - 1
-	for i := range values[:last] {
-		res.content += extractContent(values[i]) + " "
+	r := defaults()
+	return Painted{
+		style:   r.adapt(serialize(font, background)),
+		content: joinContent(values, " ", r.Sanitize) + "\n",
+		disable: r.Disable,
 	}
-	res.content += extractContent(values[last]) + "\n"
-
-	return res
 }
 
 // Paintf is like Paint but similarly to fmt.Sprintf it allows to use a model
@@ -72,13 +59,11 @@ func Paintln[color ColorType](font color, background Optional[color], values ...
 // If a Painted and/or an Highlighted item is given, they will lose their previous style
 // and provided font and background colors will be enforced
 func Paintf[color ColorType](font color, background Optional[color], model string, values ...any) Painted {
-	for i, v := range values {
-		values[i] = extractContent(v)
-	}
-
+	r := defaults()
 	return Painted{
-		style:   serialize(font, background),
-		content: fmt.Sprintf(model, values...),
+		style:   r.adapt(serialize(font, background)),
+		content: formatContent(model, values, r.Sanitize),
+		disable: r.Disable,
 	}
 }
 
@@ -86,9 +71,11 @@ func Paintf[color ColorType](font color, background Optional[color], model strin
 // If a Painted and/or an Highlighted item is given, they will lose their previous style
 // and the current styling of the brush will be enforced
 func (b Brush[color]) Paint(values ...any) Painted {
-	p := Paint(b.Foreground, b.Background, values...)
-	p.disable = b.Disable
-	return p
+	return Painted{
+		style:   b.extract(),
+		content: joinContent(values, "", b.settings().Sanitize),
+		disable: b.Disable,
+	}
 }
 
 // Paintln like Paint but similarly to fmt.Sprintln it separates values with " "
@@ -96,9 +83,11 @@ func (b Brush[color]) Paint(values ...any) Painted {
 // If a Painted and/or an Highlighted item is given, they will lose their previous style
 // and the current styling of the brush will be enforced
 func (b Brush[color]) Paintln(values ...any) Painted {
-	p := Paintln(b.Foreground, b.Background, values...)
-	p.disable = b.Disable
-	return p
+	return Painted{
+		style:   b.extract(),
+		content: joinContent(values, " ", b.settings().Sanitize) + "\n",
+		disable: b.Disable,
+	}
 }
 
 // Paintf is like Paint but similarly to fmt.Sprintf it allows to use a model
@@ -106,9 +95,11 @@ func (b Brush[color]) Paintln(values ...any) Painted {
 // If a Painted and/or an Highlighted item is given, they will lose their previous style
 // and the current styling of the brush will be enforced
 func (b Brush[color]) Paintf(model string, values ...any) Painted {
-	p := Paintf(b.Foreground, b.Background, model, values...)
-	p.disable = b.Disable
-	return p
+	return Painted{
+		style:   b.extract(),
+		content: formatContent(model, values, b.settings().Sanitize),
+		disable: b.Disable,
+	}
 }
 
 // String gives a string that contains some special sequence that will apply styling
@@ -119,15 +110,37 @@ func (p Painted) String() string {
 	return p.apply(p.content)
 }
 
+// Disabled tells if the styling of the painted item is disabled, meaning that it's shown as plain text
+func (p Painted) Disabled() bool {
+	return p.disable
+}
+
+// AppendTo appends to dst the content with the styling applied (like String) and gives back the extended buffer
+func (p Painted) AppendTo(dst []byte) []byte {
+	if p.disable {
+		return append(dst, p.content...)
+	}
+	return p.appendTo(dst, p.content)
+}
+
+// WriteTo writes on w the content with the styling applied (like String)
+func (p Painted) WriteTo(w io.Writer) (int64, error) {
+	buf := bufferPool.Get().(*[]byte)
+	*buf = p.AppendTo((*buf)[:0])
+	return writeBuffer(w, buf)
+}
+
 // Append a string at the end of the content of the painted item
-// Warning: Do not use string containing styling
+// Warning: the string is used as it is, do not use strings containing styling or
+// control characters (see Strip and Sanitize)
 func (p *Painted) Append(s string) *Painted {
 	p.content += s
 	return p
 }
 
 // Prepend a string at the start of the content of the painted item
-// Warning: Do not use string containing styling
+// Warning: the string is used as it is, do not use strings containing styling or
+// control characters (see Strip and Sanitize)
 func (p *Painted) Prepend(s string) *Painted {
 	p.content = s + p.content
 	return p
@@ -135,22 +148,30 @@ func (p *Painted) Prepend(s string) *Painted {
 
 // Replace the content of the painted item with another string
 // Is possible to use the %s to refer to embed previous content
-// Warning: Do not use string containing styling
+// Warning: the string is used as it is, do not use strings containing styling or
+// control characters (see Strip and Sanitize)
 func (p *Painted) Replace(s string) *Painted {
 	p.content = strings.ReplaceAll(s, "%s", p.content)
 	return p
 }
 
-func extractContent(value any) (content string) {
+// extractContent gives the content of the value, sanitized if the Default renderer requires it (see contentOf)
+func extractContent(value any) string {
+	return contentOf(value, defaults().Sanitize)
+}
+
+// contentOf gives the content of the value, if sanitize is set the values that are not
+// Painted or Highlighted items are considered user input and sanitized (see Sanitize)
+func contentOf(value any, sanitize bool) (content string) {
 	switch v := value.(type) {
 	case Painted:
-		content = v.content
+		return v.content
 	case *Painted:
-		content = v.content
+		return v.content
 	case Highlighted:
-		content = v.content
+		return v.content
 	case *Highlighted:
-		content = v.content
+		return v.content
 	case string:
 		content = v
 	case fmt.Stringer:
@@ -159,5 +180,28 @@ func extractContent(value any) (content string) {
 		content = fmt.Sprint(v)
 	}
 
-	return
+	if sanitize {
+		return Sanitize(content)
+	}
+	return content
+}
+
+// joinContent joins the content of the values (see contentOf) placing the separator between them
+func joinContent(values []any, separator string, sanitize bool) string {
+	var content strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			content.WriteString(separator)
+		}
+		content.WriteString(contentOf(v, sanitize))
+	}
+	return content.String()
+}
+
+// formatContent replaces the placeholders of the model with the content of the values (see contentOf)
+func formatContent(model string, values []any, sanitize bool) string {
+	for i, v := range values {
+		values[i] = contentOf(v, sanitize)
+	}
+	return fmt.Sprintf(model, values...)
 }
@@ -0,0 +1,114 @@
+package brush
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Palette holds the actual colors used by a terminal to show the 16 ANSIColor, in the same order
+type Palette [16]TrueColor
+
+// Built-in palettes of some popular terminals and themes
+var (
+	// DefaultPalette is the palette of the legacy Windows console, used by default
+	DefaultPalette = Palette{
+		{0, 0, 0}, {128, 0, 0}, {0, 128, 0}, {128, 128, 0}, {0, 0, 128}, {128, 0, 128}, {0, 128, 128}, {192, 192, 192},
+		{128, 128, 128}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0}, {0, 0, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+	}
+	// VGAPalette is the palette of the VGA text mode, used by the Linux console
+	VGAPalette = Palette{
+		{0, 0, 0}, {170, 0, 0}, {0, 170, 0}, {170, 85, 0}, {0, 0, 170}, {170, 0, 170}, {0, 170, 170}, {170, 170, 170},
+		{85, 85, 85}, {255, 85, 85}, {85, 255, 85}, {255, 255, 85}, {85, 85, 255}, {255, 85, 255}, {85, 255, 255}, {255, 255, 255},
+	}
+	// XtermPalette is the default palette of xterm
+	XtermPalette = Palette{
+		{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0}, {0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+		{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0}, {92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+	}
+	// WindowsTerminalPalette is the "Campbell" palette, default of Windows Terminal
+	WindowsTerminalPalette = Palette{
+		{12, 12, 12}, {197, 15, 31}, {19, 161, 14}, {193, 156, 0}, {0, 55, 218}, {136, 23, 152}, {58, 150, 221}, {204, 204, 204},
+		{118, 118, 118}, {231, 72, 86}, {22, 198, 12}, {249, 241, 165}, {59, 120, 255}, {180, 0, 158}, {97, 214, 214}, {242, 242, 242},
+	}
+	// SolarizedPalette is the palette of the Solarized theme
+	SolarizedPalette = Palette{
+		{7, 54, 66}, {220, 50, 47}, {133, 153, 0}, {181, 137, 0}, {38, 139, 210}, {211, 54, 130}, {42, 161, 152}, {238, 232, 213},
+		{0, 43, 54}, {203, 75, 22}, {88, 110, 117}, {101, 123, 131}, {131, 148, 150}, {108, 113, 196}, {147, 161, 161}, {253, 246, 227},
+	}
+	// DraculaPalette is the palette of the Dracula theme
+	DraculaPalette = Palette{
+		{33, 34, 44}, {255, 85, 85}, {80, 250, 123}, {241, 250, 140}, {189, 147, 249}, {255, 121, 198}, {139, 233, 253}, {248, 248, 242},
+		{98, 114, 164}, {255, 110, 110}, {105, 255, 148}, {255, 255, 165}, {214, 172, 255}, {255, 146, 223}, {164, 255, 255}, {255, 255, 255},
+	}
+)
+
+// ActivePalette is the palette of the Default renderer, it's used to convert an ANSIColor to a TrueColor and back
+// (see ToTrueColor and ToANSI): set it to the palette of your terminal to get accurate conversions and contrast checks
+var ActivePalette = DefaultPalette
+
+// Nearest gives the ANSIColor that looks the closest to the given color in the palette
+func (p Palette) Nearest(c TrueColor) (closest ANSIColor) {
+	best := -1
+	for i, candidate := range p {
+		if d := c.distance(candidate); best < 0 || d < best {
+			closest, best = ANSIColor(i), d
+		}
+	}
+	return
+}
+
+// QueryPalette asks the 16 colors to the terminal using the OSC 4 sequence, see QueryBackground for more info.
+// Colors that the terminal does not give back are taken from the ActivePalette
+func QueryPalette(in io.Reader, out io.Writer, timeout time.Duration) (Palette, error) {
+	var request strings.Builder
+	for i := range ActivePalette {
+		request.WriteString(osc + "4;" + strconv.Itoa(i) + ";?" + st)
+	}
+
+	replies, err := query(in, out, timeout, request.String())
+	if err != nil {
+		return Palette{}, err
+	}
+
+	var (
+		p     = ActivePalette
+		found bool
+	)
+	for _, reply := range replies {
+		fields := strings.SplitN(reply, ";", 3)
+		if len(fields) != 3 || fields[0] != "4" {
+			continue
+		}
+		i, err := strconv.Atoi(fields[1])
+		if err != nil || i < 0 || i >= len(p) {
+			continue
+		}
+		if p[i], err = parseOSCColor(fields[2]); err != nil {
+			return Palette{}, err
+		}
+		found = true
+	}
+	if !found {
+		return Palette{}, ErrUnsupported
+	}
+
+	return p, nil
+}
+
+// DetectPalette asks the 16 colors to the terminal that is running the application and,
+// if successful, uses them as ActivePalette. See DetectBackground for more info
+func DetectPalette(timeout time.Duration) (Palette, error) {
+	var p Palette
+	err := withRawTerminal(func(in io.Reader) (err error) {
+		p, err = QueryPalette(in, os.Stdout, timeout)
+		return
+	})
+	if err == nil {
+		ActivePalette = p
+	}
+
+	return p, err
+}
@@ -0,0 +1,69 @@
+package brush_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExamplePalette() {
+	defer func(previous brush.Palette) { brush.ActivePalette = previous }(brush.ActivePalette)
+
+	fmt.Println(brush.Red.ToTrueColor())
+	brush.ActivePalette = brush.DraculaPalette
+	fmt.Println(brush.Red.ToTrueColor())
+
+	// Output:
+	// {128 0 0}
+	// {255 85 85}
+}
+
+/* ---[ TESTS ]--- */
+
+func TestQueryPalette(t *testing.T) {
+	defer func(previous brush.Palette) { brush.ActivePalette = previous }(brush.ActivePalette)
+	brush.ActivePalette = brush.XtermPalette
+
+	var (
+		request strings.Builder
+		answer  = strings.NewReader("\x1b]4;1;rgb:dcdc/3232/2f2f\x1b\\\x1b]4;12;rgb:2626/8b8b/d2d2\a\x1b[?62c")
+	)
+
+	p, err := brush.QueryPalette(answer, &request, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(request.String(), "\x1b]4;0;?\x1b\\\x1b]4;1;?\x1b\\") {
+		t.Errorf("Unexpected request: %q", request.String())
+	}
+
+	expected := brush.XtermPalette
+	expected[brush.Red] = brush.TrueColor{Red: 220, Green: 50, Blue: 47}
+	expected[brush.BrightBlue] = brush.TrueColor{Red: 38, Green: 139, Blue: 210}
+	if p != expected {
+		t.Errorf("want: %v got: %v", expected, p)
+	}
+
+	if _, err = brush.QueryPalette(strings.NewReader("\x1b[?62c"), io.Discard, time.Second); err == nil {
+		t.Error("Expected error when the terminal does not answer")
+	}
+}
+
+func TestPalette_conversions(t *testing.T) {
+	defer func(previous brush.Palette) { brush.ActivePalette = previous }(brush.ActivePalette)
+
+	for _, p := range []brush.Palette{brush.VGAPalette, brush.XtermPalette, brush.WindowsTerminalPalette, brush.SolarizedPalette, brush.DraculaPalette} {
+		brush.ActivePalette = p
+		for ansi := brush.Black; ansi <= brush.BrightWhite; ansi++ {
+			if got := p[ansi].ToANSI(); got != ansi {
+				t.Errorf("Palette %v: expected %v to be converted back to itself, got %v", p, ansi, got)
+			}
+		}
+	}
+}
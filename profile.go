@@ -0,0 +1,107 @@
+package brush
+
+import (
+	"os"
+	"strings"
+)
+
+// Profile represents the set of colors that a terminal is able to show
+type Profile uint8
+
+const (
+	ProfileTrueColor Profile = iota // ProfileTrueColor supports any TrueColor, being the zero value colors are not converted unless asked
+	ProfileANSI                     // ProfileANSI supports only the 16 ANSIColor
+	ProfileExtended                 // ProfileExtended supports the 256 ExtendedANSIColor
+)
+
+// String gives the name of the profile
+func (p Profile) String() string {
+	switch p {
+	case ProfileANSI:
+		return "ansi"
+	case ProfileExtended:
+		return "256"
+	case ProfileTrueColor:
+		return "truecolor"
+	}
+	return "unknown"
+}
+
+// DetectProfile guesses the profile of the terminal from the COLORTERM and TERM environment variables
+func DetectProfile() Profile {
+	switch colorterm := strings.ToLower(os.Getenv("COLORTERM")); colorterm {
+	case "truecolor", "24bit":
+		return ProfileTrueColor
+	}
+
+	if strings.Contains(os.Getenv("TERM"), "256color") {
+		return ProfileExtended
+	}
+	return ProfileANSI
+}
+
+// Convert gives the color of the profile that is the closest to the given one,
+// the ANSIColor are picked using the palette of the Default renderer
+func (p Profile) Convert(c TrueColor) Color {
+	r := defaults()
+	converted, _ := p.convert(c, r.palette())
+	return converted
+}
+
+// convert gives the color of the profile that is the closest to the given one and how it looks
+func (p Profile) convert(c TrueColor, palette Palette) (Color, TrueColor) {
+	switch p {
+	case ProfileANSI:
+		ansi := palette.Nearest(c)
+		return ToColor(ansi), palette[ansi]
+	case ProfileExtended:
+		extended := c.ToExtended()
+		return ToColor(extended), extended.ToTrueColor()
+	}
+	return ToColor(c), c
+}
+
+// distance gives the squared euclidean distance between two colors
+func (c TrueColor) distance(other TrueColor) int {
+	dr, dg, db := int(c.Red)-int(other.Red), int(c.Green)-int(other.Green), int(c.Blue)-int(other.Blue)
+	return dr*dr + dg*dg + db*db
+}
+
+// ToANSI gives the closest ANSIColor according to the palette of the Default renderer (the ActivePalette unless replaced)
+func (c TrueColor) ToANSI() ANSIColor {
+	r := defaults()
+	return r.palette().Nearest(c)
+}
+
+var cubeLevels = [...]uint8{0, 95, 135, 175, 215, 255}
+
+// ToExtended gives the closest ExtendedANSIColor, picking it from the RGB cube or the GrayScale
+// (the first 16 colors are not considered because they vary between terminals)
+func (c TrueColor) ToExtended() ExtendedANSIColor {
+	level := func(component uint8) ColorIntensity {
+		var closest ColorIntensity
+		for i, l := range cubeLevels {
+			if absDiff(component, l) < absDiff(component, cubeLevels[closest]) {
+				closest = ColorIntensity(i)
+			}
+		}
+		return closest
+	}
+	cube := RGB(level(c.Red), level(c.Green), level(c.Blue))
+
+	// the gray scale goes from 8 to 238 with steps of 10
+	avg := (int(c.Red) + int(c.Green) + int(c.Blue)) / 3
+	gray := ExtendedANSIColor(232 + min(23, max(0, (avg-3)/10)))
+
+	if c.distance(gray.ToTrueColor()) < c.distance(cube.ToTrueColor()) {
+		return gray
+	}
+	return cube
+}
+
+func absDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,196 @@
+package brush
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// interactive tells if the output written on w can use styling and special sequences,
+// following the same rules of Disable and DisableIfNotTTY or, if it has been replaced, of the Default renderer
+func interactive(w io.Writer) bool {
+	if r := defaultRenderer.Load(); r != nil {
+		f, ok := w.(*os.File)
+		return !r.Disable && (w == r.output() || ok && term.IsTerminal(int(f.Fd())))
+	}
+
+	if Disable {
+		return false
+	}
+	if w == os.Stdout {
+		return !DisableIfNotTTY || isATTY
+	}
+
+	f, ok := w.(*os.File)
+	return !DisableIfNotTTY || ok && term.IsTerminal(int(f.Fd()))
+}
+
+var partialBlocks = [...]string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// ProgressBar shows the progress of a task, use Set or Add to update it.
+// If the Output is interactive (see Disable and DisableIfNotTTY) the bar will be updated in place,
+// otherwise it will be written only when calling Finish. It's safe to use from multiple goroutines
+type ProgressBar struct {
+	Total    int64       // Total is the value that represents the completion of the task
+	Width    int         // Width is the number of cells of the bar (stats excluded), 30 if zero
+	Fill     Painter     // Fill is used to paint the completed part of the bar
+	Empty    Painter     // Empty is used to paint the remaining part of the bar
+	Text     Painter     // Text is used to paint the percentage, the throughput and the ETA
+	Gradient []TrueColor // Gradient colors the font of each completed cell depending on its position
+	Unit     string      // Unit of measure of the values used on the throughput (ex. "B" gives "10.0 B/s")
+	Output   io.Writer   // Output is where the bar will be written, os.Stdout if nil
+	Started  time.Time   // Started is when the task started, if zero it's set on the first update
+
+	current int64
+	mu      sync.Mutex
+}
+
+// NewProgressBar creates a new progress bar for a task that completes when reaching the given total
+func NewProgressBar(total int64) *ProgressBar {
+	return &ProgressBar{Total: total}
+}
+
+// Set updates the current progress and redraws the bar
+func (p *ProgressBar) Set(current int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.update(current)
+}
+
+// Add increases the current progress by n and redraws the bar
+func (p *ProgressBar) Add(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.update(p.current + n)
+}
+
+func (p *ProgressBar) update(current int64) {
+	if p.Started.IsZero() {
+		p.Started = time.Now()
+	}
+	p.current = current
+	if interactive(p.output()) {
+		fmt.Fprint(p.output(), "\r", p.render(), ClearLineRight().sequence)
+	}
+}
+
+// Finish writes the final state of the bar followed by a new line
+func (p *ProgressBar) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if interactive(p.output()) {
+		fmt.Fprint(p.output(), "\r", p.render(), ClearLineRight().sequence, "\n")
+	} else {
+		fmt.Fprintln(p.output(), p.render())
+	}
+}
+
+func (p *ProgressBar) output() io.Writer {
+	if p.Output == nil {
+		return os.Stdout
+	}
+	return p.Output
+}
+
+// Render gives back the current state of the bar followed by the stats
+func (p *ProgressBar) Render() Highlighted {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.render()
+}
+
+func (p *ProgressBar) render() Highlighted {
+	var (
+		width = p.Width
+		ratio float64
+	)
+	if width <= 0 {
+		width = 30
+	}
+	if p.Total > 0 {
+		ratio = math.Max(0, math.Min(1, float64(p.current)/float64(p.Total)))
+	}
+
+	var (
+		eighths = int(ratio * float64(width*8))
+		full    = eighths / 8
+		parts   = make([]any, 0, width+2)
+	)
+
+	for i := 0; i < full; i++ {
+		parts = append(parts, p.fillCell(i, width, "█"))
+	}
+	if partial := partialBlocks[eighths%8]; partial != "" {
+		parts = append(parts, p.fillCell(full, width, partial))
+		full++
+	}
+	if empty := strings.Repeat("░", width-full); empty != "" {
+		parts = append(parts, Join(empty).layer(layerOf(p.Empty)))
+	}
+
+	return Join(append(parts, Join(" ", p.stats(ratio)).layer(layerOf(p.Text)))...)
+}
+
+// fillCell paints a completed cell with the Fill brush and the color of the Gradient at its position.
+// Like any new brush the Gradient follows the Default renderer: it's not used if the styling is disabled
+// and its colors are converted to the ones supported by the profile
+func (p *ProgressBar) fillCell(i, width int, block string) Highlighted {
+	cell := Join(block)
+	if r := defaults(); len(p.Gradient) > 0 && !r.Disable {
+		var position float64
+		if width > 1 {
+			position = float64(i) / float64(width-1)
+		}
+		color := r.adapt(style{foreground: gradient(p.Gradient, position).foreground()})
+		cell = cell.layer(&color)
+	}
+
+	return cell.layer(layerOf(p.Fill))
+}
+
+// gradient gives the color at the given position (from 0 to 1) of a gradient made of the given colors
+func gradient(colors []TrueColor, position float64) TrueColor {
+	if len(colors) == 1 {
+		return colors[0]
+	}
+
+	scaled := position * float64(len(colors)-1)
+	i := min(int(scaled), len(colors)-2)
+	return colors[i].mix(colors[i+1], scaled-float64(i))
+}
+
+func (p *ProgressBar) stats(ratio float64) string {
+	stats := fmt.Sprintf("%3.0f%%", ratio*100)
+
+	elapsed := time.Since(p.Started)
+	if p.Started.IsZero() || elapsed <= 0 {
+		return stats
+	}
+
+	rate := float64(p.current) / elapsed.Seconds()
+	stats += "  " + formatRate(rate, p.Unit)
+
+	if rate > 0 && p.current < p.Total {
+		eta := time.Duration(float64(p.Total-p.current) / rate * float64(time.Second))
+		stats += "  ETA " + eta.Round(time.Second).String()
+	}
+
+	return stats
+}
+
+func formatRate(rate float64, unit string) string {
+	if unit != "" {
+		unit = " " + unit
+	}
+	return fmt.Sprintf("%.1f%s/s", rate, unit)
+}
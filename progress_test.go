@@ -0,0 +1,106 @@
+package brush_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleProgressBar() {
+	bar := brush.NewProgressBar(200)
+	bar.Width, bar.Unit = 20, "files"
+	bar.Output = io.Discard // updates are written here, by default on os.Stdout
+	bar.Started = time.Now().Add(-10 * time.Second)
+
+	bar.Set(50)
+	fmt.Println(bar.Render())
+
+	// Output: █████░░░░░░░░░░░░░░░  25%  5.0 files/s  ETA 30s
+}
+
+/* ---[ TESTS ]--- */
+
+func TestProgressBar_Render(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		out strings.Builder
+		bar = brush.ProgressBar{Total: 100, Width: 10, Output: &out}
+	)
+
+	assert(t, "Not started", bar.Render().String(), "░░░░░░░░░░   0%")
+
+	bar.Started = time.Now().Add(-10 * time.Second)
+	bar.Set(55)
+	assert(t, "Partial block", bar.Render().String(), "█████▌░░░░  55%  5.5/s  ETA 8s")
+	bar.Add(45)
+	assert(t, "Completed", bar.Render().String(), "██████████ 100%  10.0/s")
+	bar.Set(200)
+	assert(t, "Overflow", bar.Render().String(), "██████████ 100%  20.0/s")
+
+	if lines := strings.Split(out.String(), "\r"); len(lines) != 4 || !strings.HasSuffix(lines[3], "\x1b[K") {
+		t.Errorf("Expected 3 updates in place, got: %q", out.String())
+	}
+	bar.Finish()
+	if !strings.HasSuffix(out.String(), "\x1b[K\n") {
+		t.Errorf("Expected final new line, got: %q", out.String())
+	}
+
+	bar = brush.ProgressBar{Total: 4, Width: 4, Gradient: []brush.TrueColor{{255, 0, 0}, {0, 0, 255}}, Output: &out}
+	bar.Started = time.Now().Add(-time.Second)
+	bar.Set(3)
+	assert(t, "Gradient", bar.Render().Markup(), "[#ff0000]█[/][#aa0055]█[/][#5500aa]█[/]░  75%  3.0/s  ETA 0s")
+
+	// the gradient follows the same rules of the brushes and not the output
+	brush.DisableIfNotTTY = true
+	if colored := strings.Contains(bar.Render().String(), "\x1b["); colored == brush.New(brush.Green, nil).Disable {
+		t.Error("Expected the gradient to be disabled like a new brush, got:", bar.Render().Markup())
+	}
+	brush.DisableIfNotTTY = false
+
+	// and its colors are converted to the profile of the default renderer
+	previous := brush.SetDefault(&brush.Renderer{Profile: brush.ProfileExtended})
+	if rendered := bar.Render().String(); strings.Contains(rendered, "38;2;") || !strings.Contains(rendered, "38;5;") {
+		t.Errorf("Expected the gradient to use extended colors, got: %q", rendered)
+	}
+	brush.SetDefault(previous)
+
+	fill := brush.New(brush.Green, brush.UseColor(brush.Black))
+	empty := brush.New(brush.BrightBlack, brush.UseColor(brush.Black))
+	fill.Disable, empty.Disable = false, false
+	bar = brush.ProgressBar{Total: 2, Width: 2, Fill: fill, Empty: empty, Output: &out}
+	bar.Set(1)
+	if markup := bar.Render().Markup(); !strings.HasPrefix(markup, "[green on black]█[/][bright-black on black]░[/]  50%") {
+		t.Error("Unexpected brushes on bar:", markup)
+	}
+}
+
+func TestProgressBar_Concurrency(t *testing.T) {
+	var (
+		out strings.Builder
+		bar = brush.ProgressBar{Total: 1000, Output: &out}
+		wg  sync.WaitGroup
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				bar.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if r := bar.Render().String(); !strings.Contains(r, "100%") {
+		t.Error("Lost some updates:", r)
+	}
+}
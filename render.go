@@ -0,0 +1,115 @@
+package brush
+
+import (
+	"io"
+	"sync"
+	"unsafe"
+)
+
+// sequencesSize is an estimate of the length of the special sequences around a styled section
+const sequencesSize = 24
+
+// sgrKey identifies the part of a style that is represented by the SGR sequence
+type sgrKey struct {
+	foreground, background string
+	attributes             Attribute
+}
+
+// maxSGRCache limits the number of cached sequences, so that styles that are used only once
+// (like the ones of a gradient) do not grow the cache forever: when full it's emptied
+const maxSGRCache = 1024
+
+var (
+	sgrCache   = map[sgrKey]string{}
+	sgrCacheMu sync.RWMutex
+)
+
+// sgr gives the sequence that enables the style, empty if there is nothing to enable
+func (s style) sgr() string {
+	key := sgrKey{s.foreground, s.background, s.attributes}
+
+	sgrCacheMu.RLock()
+	seq, ok := sgrCache[key]
+	sgrCacheMu.RUnlock()
+	if ok {
+		return seq
+	}
+
+	var buf = make([]byte, 0, len(csi)+len(s.foreground)+len(s.background)+8)
+	for _, code := range [...]string{s.attributes.codes(), s.foreground, s.background} {
+		if code == "" {
+			continue
+		}
+		if len(buf) == 0 {
+			buf = append(buf, csi...)
+		} else {
+			buf = append(buf, ';')
+		}
+		buf = append(buf, code...)
+	}
+	if len(buf) > 0 {
+		seq = string(append(buf, 'm'))
+	}
+
+	sgrCacheMu.Lock()
+	if len(sgrCache) >= maxSGRCache {
+		clear(sgrCache)
+	}
+	sgrCache[key] = seq
+	sgrCacheMu.Unlock()
+
+	return seq
+}
+
+// appendTo appends to dst the content surrounded by the sequences that apply the style,
+// including the OSC 8 ones that make it a clickable link
+func (s style) appendTo(dst []byte, content string) []byte {
+	if s.link != "" {
+		dst = append(dst, osc+"8;"...)
+		if s.linkID != "" {
+			dst = append(dst, "id="...)
+			dst = append(dst, s.linkID...)
+		}
+		dst = append(dst, ';')
+		dst = append(dst, s.link...)
+		dst = append(dst, st...)
+	}
+
+	if seq := s.sgr(); seq != "" {
+		dst = append(dst, seq...)
+		dst = append(dst, content...)
+		dst = append(dst, csi+colorReset+"m"...)
+	} else {
+		dst = append(dst, content...)
+	}
+
+	if s.link != "" {
+		dst = append(dst, osc+"8;;"+st...)
+	}
+	return dst
+}
+
+func (s style) apply(content string) string {
+	return bytesToString(s.appendTo(make([]byte, 0, len(content)+len(s.link)+len(s.linkID)+sequencesSize), content))
+}
+
+// bytesToString converts the buffer to a string without copying it, like strings.Builder does.
+// The buffer must not be modified afterwards
+func bytesToString(buf []byte) string {
+	return unsafe.String(unsafe.SliceData(buf), len(buf))
+}
+
+// maxPooledBuffer is the capacity over which buffers are not reused, to not keep huge ones in memory
+const maxPooledBuffer = 64 * 1024
+
+var bufferPool = sync.Pool{New: func() any { return new([]byte) }}
+
+// writeBuffer writes on w the buffer taken from the pool, giving it back afterwards
+func writeBuffer(w io.Writer, buf *[]byte) (int64, error) {
+	n, err := w.Write(*buf)
+	if cap(*buf) <= maxPooledBuffer {
+		bufferPool.Put(buf)
+	}
+
+	return int64(n), err
+}
@@ -0,0 +1,141 @@
+package brush_test
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ TESTS ]--- */
+
+func TestRender_consistency(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		p = brush.Paint(brush.TrueColor{Red: 10, Green: 200, Blue: 30}, brush.UseColor(brush.TrueColor{}), "painted")
+		h = brush.New(brush.Red, nil).Highlight("a lot of text with a lot of matches", regexp.MustCompile("lot"))
+	)
+	p.Link("https://example.com", "1")
+
+	for _, value := range []interface {
+		String() string
+		AppendTo([]byte) []byte
+		WriteTo(io.Writer) (int64, error)
+	}{p, h} {
+		expected := value.String()
+
+		if got := string(value.AppendTo([]byte("prefix:"))); got != "prefix:"+expected {
+			t.Errorf("AppendTo | want: %q got: %q", "prefix:"+expected, got)
+		}
+
+		var out strings.Builder
+		if n, err := value.WriteTo(&out); err != nil || n != int64(len(expected)) || out.String() != expected {
+			t.Errorf("WriteTo | want: %q got: %q (%d bytes, error: %v)", expected, out.String(), n, err)
+		}
+	}
+}
+
+func TestRender_allocations(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		p   = brush.New(brush.RGB(5, 2, 0), brush.UseColor(brush.GrayScale(3))).Paint("some text")
+		h   = brush.New(brush.Red, nil).Highlight(strings.Repeat("error and more text ", 100), regexp.MustCompile("error"))
+		buf = make([]byte, 0, 8*1024)
+	)
+
+	var cases = []struct {
+		name   string
+		run    func()
+		maxAvg float64
+	}{
+		{"Painted.String", func() { _ = p.String() }, 1},
+		{"Painted.AppendTo", func() { buf = p.AppendTo(buf[:0]) }, 0},
+		{"Highlighted.String", func() { _ = h.String() }, 1},
+		{"Highlighted.AppendTo", func() { buf = h.AppendTo(buf[:0]) }, 0},
+	}
+
+	for _, c := range cases {
+		if got := testing.AllocsPerRun(100, c.run); got > c.maxAvg {
+			t.Errorf("%s: expected at most %.0f allocations, got %.2f", c.name, c.maxAvg, got)
+		}
+	}
+}
+
+/* ---[ BENCHMARKS ]--- */
+
+func BenchmarkPainted_String(b *testing.B) {
+	brush.DisableIfNotTTY = false
+	p := brush.Paint(brush.TrueColor{Red: 255, Green: 135}, nil, "some painted text")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = p.String()
+	}
+}
+
+func BenchmarkHighlighted_String(b *testing.B) {
+	brush.DisableIfNotTTY = false
+	h := brush.New(brush.Red, nil).Highlight(strings.Repeat("ERROR disk full\nINFO retrying\n", 1000), regexp.MustCompile("ERROR"))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = h.String()
+	}
+}
+
+func BenchmarkHighlighted_WriteTo(b *testing.B) {
+	brush.DisableIfNotTTY = false
+	h := brush.New(brush.Red, nil).Highlight(strings.Repeat("ERROR disk full\nINFO retrying\n", 1000), regexp.MustCompile("ERROR"))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.WriteTo(io.Discard)
+	}
+}
+
+func BenchmarkPaintln(b *testing.B) {
+	brush.DisableIfNotTTY = false
+	values := []any{"found", 42, "matches in", "file.go"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = brush.Paintln(brush.Green, nil, values...).String()
+	}
+}
+
+func BenchmarkHighlightFunc(b *testing.B) {
+	brush.DisableIfNotTTY = false
+	var (
+		log  = strings.Repeat("ERROR disk full\nINFO retrying\n", 1000)
+		find = regexp.MustCompile("ERROR")
+		red  = brush.New(brush.Red, nil)
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		red.HighlightFunc(log, find, strings.ToLower)
+	}
+}
+
+func BenchmarkTable_Render(b *testing.B) {
+	brush.DisableIfNotTTY = false
+	table := brush.Table{Header: []any{"name", "size"}, Stripes: []brush.Painter{brush.New(brush.White, brush.UseColor(brush.Black))}}
+	for i := 0; i < 500; i++ {
+		table.Rows = append(table.Rows, brush.Row{Cells: []any{"file.go", i}})
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		table.Render()
+	}
+}
@@ -0,0 +1,255 @@
+package brush
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"regexp"
+	"sync/atomic"
+
+	"golang.org/x/term"
+)
+
+// Renderer decides how the styled output written on a specific writer looks: if it's enabled,
+// which colors the terminal supports and its palette. Use it to paint, highlight and print values
+// for that writer, independently of the package level variables: a program can use a renderer
+// for a terminal and another one for a file at the same time.
+// The package level functions, Brush and Style use the Default renderer
+type Renderer struct {
+	Output  io.Writer // Output is where the Print methods write, os.Stdout if nil
+	Profile Profile   // Profile is the set of colors supported, the others are converted to the closest one (none if zero)
+	Palette Palette   // Palette is used to convert colors from and to the 16 ANSIColor, the ActivePalette if zero
+	Disable bool      // Disable removes any styling
+
+	// Sanitize escapes the control characters (see Sanitize) of the values given to Paint, Embed, Highlight
+	// and Print (and all their variants), so that content coming from users, files or the network
+	// cannot inject escape sequences in the terminal. Painted and Highlighted values are not affected
+	Sanitize bool
+}
+
+var defaultRenderer atomic.Pointer[Renderer]
+
+// Default gives the settings of the renderer used by the package level functions (Paint, New, Markup, ...),
+// Brush and Style. Unless it's replaced using SetDefault, it writes on os.Stdout, it's disabled by Disable
+// and DisableIfNotTTY, it uses the ActivePalette and it does not convert any color (ProfileTrueColor)
+func Default() *Renderer {
+	r := defaults()
+	return &r
+}
+
+// SetDefault replaces the renderer used by the package level functions giving back the previous one,
+// nil restores the one that follows the package level variables. Unlike changing those variables,
+// it's safe to call while other goroutines are painting. The renderer should not be modified afterward
+func SetDefault(r *Renderer) (previous *Renderer) {
+	return defaultRenderer.Swap(r)
+}
+
+// defaults gives the current settings of the default renderer
+func defaults() Renderer {
+	if r := defaultRenderer.Load(); r != nil {
+		return *r
+	}
+	return Renderer{
+		Output:   os.Stdout,
+		Profile:  ProfileTrueColor,
+		Palette:  ActivePalette,
+		Disable:  Disable || DisableIfNotTTY && !isATTY,
+		Sanitize: SanitizeInput,
+	}
+}
+
+// disabled tells if the default renderer is disabled
+func disabled() bool {
+	return defaults().Disable
+}
+
+// palette gives the palette used by the renderer
+func (r *Renderer) palette() Palette {
+	if r.Palette == (Palette{}) {
+		return ActivePalette
+	}
+	return r.Palette
+}
+
+// NewRenderer creates a new renderer for the given output: it's disabled if the output is not a terminal or
+// the NO_COLOR environment variable is set, the profile is detected using DetectProfile and the palette is the ActivePalette
+func NewRenderer(output io.Writer) *Renderer {
+	var (
+		r      = &Renderer{Output: output, Palette: ActivePalette, Profile: ProfileTrueColor}
+		f, tty = output.(*os.File)
+	)
+	if tty = tty && term.IsTerminal(int(f.Fd())); tty {
+		r.Profile = DetectProfile()
+	}
+	r.Disable = os.Getenv("NO_COLOR") != "" || !tty
+
+	return r
+}
+
+func (r *Renderer) output() io.Writer {
+	if r.Output == nil {
+		return os.Stdout
+	}
+	return r.Output
+}
+
+// adapt converts the colors of the style to the ones supported by the renderer
+func (r Renderer) adapt(s style) style {
+	if r.Profile == ProfileTrueColor {
+		return s
+	}
+
+	convert := func(code string, foreground bool) string {
+		c, ok := decodeColor(code)
+		if !ok {
+			return code
+		}
+
+		switch v := c.value.(type) {
+		case TrueColor:
+			if r.Profile == ProfileANSI {
+				c = ToColor(r.palette().Nearest(v))
+			} else {
+				c = ToColor(v.ToExtended())
+			}
+		case ExtendedANSIColor:
+			if r.Profile != ProfileANSI {
+				return code
+			}
+			// the first 16 extended colors are the ANSIColor themselves
+			if v < 16 {
+				c = ToColor(ANSIColor(v))
+			} else {
+				c = ToColor(r.palette().Nearest(v.ToTrueColor()))
+			}
+		default:
+			return code
+		}
+
+		if foreground {
+			return c.foreground()
+		}
+		return c.background()
+	}
+
+	s.foreground, s.background = convert(s.foreground, true), convert(s.background, false)
+	return s
+}
+
+// New creates a new brush that uses the style as default and follows the renderer settings
+func (r *Renderer) New(s Style) Brush[Color] {
+	b := s.Brush()
+	b.Disable, b.renderer = r.Disable, r
+	return b
+}
+
+// Paint some values (joined without separator) with the style, like the Paint function
+func (r *Renderer) Paint(s Style, values ...any) Painted {
+	return Painted{
+		style:   r.adapt(s.style),
+		content: joinContent(values, "", r.Sanitize),
+		disable: r.Disable,
+	}
+}
+
+// Embed joins the values using the style for the ones that are not styled, like the Embed method of Brush
+func (r *Renderer) Embed(s Style, values ...any) Highlighted {
+	return r.Render(embed(&s.style, r.Disable, r.Sanitize, values))
+}
+
+// Highlight only the matching part of the given string with the style, like the Highlight method of Brush
+func (r *Renderer) Highlight(s Style, text string, find *regexp.Regexp) Highlighted {
+	return r.New(s).Highlight(text, find)
+}
+
+// Render joins the values (like Join) adapting their styling to the renderer:
+// without any style if it's disabled or with the colors converted to the supported ones.
+// Painted values keep their style even if they were created while the default renderer was disabled
+func (r *Renderer) Render(values ...any) Highlighted {
+	var adapted []any
+	for i, v := range values {
+		var replacement any
+		switch v := v.(type) {
+		case Painted:
+			v.disable = false
+			replacement = v
+		case *Painted:
+			p := *v
+			p.disable = false
+			replacement = p
+		case Highlighted, *Highlighted:
+			continue
+		default:
+			if !r.Sanitize {
+				continue
+			}
+			replacement = contentOf(v, true)
+		}
+
+		if adapted == nil {
+			adapted = append([]any(nil), values...)
+		}
+		adapted[i] = replacement
+	}
+	if adapted != nil {
+		values = adapted
+	}
+
+	h := Join(values...)
+	h.disable = r.Disable
+	if r.Disable {
+		h.sectors = nil
+		return h
+	}
+
+	for i, sec := range h.sectors {
+		if sec.style != nil {
+			adapted := r.adapt(*sec.style)
+			h.sectors[i].style = &adapted
+		}
+	}
+	return h
+}
+
+// RenderImage is like the RenderImage function but uses the profile and the palette of the renderer
+func (r *Renderer) RenderImage(img image.Image, columns, rows int) Highlighted {
+	return renderImage(img, columns, rows, r.Profile, r.palette(), r.Disable)
+}
+
+// Print writes the values on the output of the renderer (joined without separator), see Render
+func (r *Renderer) Print(values ...any) (int, error) {
+	n, err := r.Render(values...).WriteTo(r.output())
+	return int(n), err
+}
+
+// Println is like Print but the values are separated by " " and a "\n" is added at the end
+func (r *Renderer) Println(values ...any) (int, error) {
+	var spaced = make([]any, 0, len(values)*2)
+	for i, v := range values {
+		if i > 0 {
+			spaced = append(spaced, " ")
+		}
+		spaced = append(spaced, v)
+	}
+	return r.Print(append(spaced, "\n")...)
+}
+
+// Printf writes on the output of the renderer the model with the placeholders replaced by the values (like fmt.Printf),
+// the Painted and Highlighted values keep their styling and, if Sanitize is set, the others are sanitized once formatted
+func (r *Renderer) Printf(model string, values ...any) (int, error) {
+	var formatted = make([]any, len(values))
+	for i, v := range values {
+		switch v.(type) {
+		case Painted, *Painted, Highlighted, *Highlighted:
+			formatted[i] = r.Render(v)
+		default:
+			if r.Sanitize {
+				formatted[i] = sanitized{v}
+			} else {
+				formatted[i] = v
+			}
+		}
+	}
+	return fmt.Fprintf(r.output(), model, formatted...)
+}
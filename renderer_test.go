@@ -0,0 +1,195 @@
+package brush_test
+
+import (
+	"image"
+	"image/color"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleRenderer() {
+	var (
+		terminal = &brush.Renderer{Output: os.Stdout, Profile: brush.ProfileExtended}
+		logFile  = &brush.Renderer{Output: os.Stdout, Disable: true}
+		orange   = brush.NewStyle(brush.TrueColor{Red: 255, Green: 135}, nil)
+	)
+
+	message := terminal.Paint(orange, "disk almost full")
+	terminal.Println("warning:", message)
+	logFile.Println("warning:", message)
+
+	// Output:
+	// warning: [38;5;208mdisk almost full[0m
+	// warning: disk almost full
+}
+
+/* ---[ TESTS ]--- */
+
+func TestRenderer(t *testing.T) {
+	var (
+		out    strings.Builder
+		r      = &brush.Renderer{Output: &out, Profile: brush.ProfileANSI, Palette: brush.XtermPalette}
+		purple = brush.NewStyle(brush.TrueColor{Red: 200, Green: 10, Blue: 190}, brush.UseColor(brush.TrueColor{Red: 90, Green: 90, Blue: 250}))
+	)
+
+	if got, want := r.Paint(purple, "x").String(), "\x1b[35;104mx\x1b[0m"; got != want {
+		t.Errorf("Paint | want: %q got: %q", want, got)
+	}
+
+	b := r.New(brush.NewStyle(brush.ExtendedANSIColor(196), nil))
+	if got, want := b.Paint("x").String(), "\x1b[91mx\x1b[0m"; got != want {
+		t.Errorf("New | want: %q got: %q", want, got)
+	}
+
+	h := r.Highlight(brush.NewStyle(brush.Red, nil), "a-b-a", regexp.MustCompile("a"))
+	if got, want := h.String(), "\x1b[31ma\x1b[0m-b-\x1b[31ma\x1b[0m"; got != want {
+		t.Errorf("Highlight | want: %q got: %q", want, got)
+	}
+
+	e := r.Embed(brush.NewStyle(brush.Red, nil), "a", 1)
+	if got, want := e.String(), "\x1b[31ma\x1b[0m\x1b[31m1\x1b[0m"; got != want {
+		t.Errorf("Embed | want: %q got: %q", want, got)
+	}
+
+	r.Printf("%s=%d\n", r.Paint(brush.NewStyle(brush.Green, nil), "n"), 3)
+	if got, want := out.String(), "\x1b[32mn\x1b[0m=3\n"; got != want {
+		t.Errorf("Printf | want: %q got: %q", want, got)
+	}
+
+	r.Disable = true
+	out.Reset()
+	r.Println(h, r.Paint(purple, "x"))
+	if got, want := out.String(), "a-b-a x\n"; got != want {
+		t.Errorf("Disabled | want: %q got: %q", want, got)
+	}
+}
+
+func TestRenderer_zero(t *testing.T) {
+	var (
+		out strings.Builder
+		r   = &brush.Renderer{Output: &out}
+	)
+
+	green := brush.NewStyle(brush.TrueColor{Red: 10, Green: 200, Blue: 30}, nil)
+	if got, want := r.Paint(green, "x").String(), "\x1b[38;2;10;200;30mx\x1b[0m"; got != want {
+		t.Errorf("Paint | want: %q got: %q", want, got)
+	}
+
+	r.New(green).Println("hello")
+	if got, want := out.String(), "\x1b[38;2;10;200;30mhello\n\x1b[0m"; got != want {
+		t.Errorf("Brush.Println | want: %q got: %q", want, got)
+	}
+
+	r.Profile = brush.ProfileANSI
+	if got, want := r.Paint(brush.NewStyle(brush.ExtendedANSIColor(9), brush.UseColor(brush.ExtendedANSIColor(4))), "x").String(), "\x1b[91;44mx\x1b[0m"; got != want {
+		t.Errorf("Extended as ANSI | want: %q got: %q", want, got)
+	}
+}
+
+func TestNewRenderer(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if r := brush.NewRenderer(f); !r.Disable {
+		t.Error("Expected renderer for a file to be disabled")
+	}
+	if r := brush.NewRenderer(&strings.Builder{}); !r.Disable {
+		t.Error("Expected renderer for a generic writer to be disabled")
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	defer brush.SetDefault(brush.SetDefault(&brush.Renderer{Disable: true}))
+
+	var (
+		out    strings.Builder
+		r      = &brush.Renderer{Output: &out, Profile: brush.ProfileTrueColor}
+		status = brush.Paint(brush.Green, nil, "ok")
+	)
+
+	if got := status.String(); got != "ok" {
+		t.Fatalf("Expected the default renderer to be disabled, got %q", got)
+	}
+	r.Println("status:", status, &status)
+	if got, want := out.String(), "status: \x1b[32mok\x1b[0m \x1b[32mok\x1b[0m\n"; got != want {
+		t.Errorf("Disabled painted | want: %q got: %q", want, got)
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	var (
+		custom   = &brush.Renderer{Profile: brush.ProfileANSI, Palette: brush.XtermPalette}
+		previous = brush.SetDefault(custom)
+		purple   = brush.TrueColor{Red: 200, Green: 10, Blue: 190}
+	)
+	defer brush.SetDefault(previous)
+
+	if got := brush.Default(); *got != *custom {
+		t.Errorf("Expected Default to give the custom renderer, got %+v", got)
+	}
+	if got, want := brush.Paint(purple, nil, "x").String(), "\x1b[35mx\x1b[0m"; got != want {
+		t.Errorf("Paint | want: %q got: %q", want, got)
+	}
+	if got, want := brush.New(purple, nil).Paint("x").String(), "\x1b[35mx\x1b[0m"; got != want {
+		t.Errorf("New | want: %q got: %q", want, got)
+	}
+	if got, want := brush.MustMarkup("[#c80abe]x[/]").String(), "\x1b[35mx\x1b[0m"; got != want {
+		t.Errorf("Markup | want: %q got: %q", want, got)
+	}
+	if got := brush.Magenta.ToTrueColor(); got != brush.XtermPalette[brush.Magenta] {
+		t.Errorf("ToTrueColor | want: %v got: %v", brush.XtermPalette[brush.Magenta], got)
+	}
+
+	// a renderer keeps its own settings whatever the default one is
+	r := &brush.Renderer{Profile: brush.ProfileTrueColor}
+	if got, want := r.New(brush.NewStyle(purple, nil)).Paint("x").String(), "\x1b[38;2;200;10;190mx\x1b[0m"; got != want {
+		t.Errorf("Renderer.New | want: %q got: %q", want, got)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, 1, 2))
+	img.Set(0, 0, color.RGBA{200, 10, 190, 255})
+	img.Set(0, 1, color.RGBA{200, 10, 190, 255})
+	r = &brush.Renderer{Profile: brush.ProfileANSI, Palette: brush.DraculaPalette}
+	if got, want := r.RenderImage(img, 1, 1).Markup(), "[magenta on magenta]▀[/]"; got != want {
+		t.Errorf("Renderer.RenderImage | want: %q got: %q", want, got)
+	}
+
+	brush.SetDefault(nil)
+	if got := brush.Default(); got.Palette != brush.ActivePalette || got.Profile != brush.ProfileTrueColor {
+		t.Errorf("Expected Default to follow the package variables, got %+v", got)
+	}
+}
+
+func TestSetDefault_concurrent(t *testing.T) {
+	defer brush.SetDefault(brush.SetDefault(nil))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				brush.SetDefault(&brush.Renderer{Disable: j%2 == 0, Profile: brush.ProfileTrueColor})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if s := brush.Paint(brush.Red, nil, "x").String(); s != "x" && s != "\x1b[31mx\x1b[0m" {
+					t.Errorf("Unexpected output %q", s)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,146 @@
+package brush
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SanitizeInput makes the Default renderer sanitize the values given to Paint, Embed, Highlight (and all their variants),
+// see the Sanitize field of Renderer
+var SanitizeInput = false
+
+// c1 contains the 8-bit control characters that start a sequence, encoded as UTF-8
+const c1 = "\u0090\u0098\u009b\u009d\u009e\u009f"
+
+// Strip removes from the string all the escape sequences (CSI like colors and cursor movements,
+// OSC like hyperlinks and titles, and the others), giving back only the text.
+// Useful to write on log files the output that has been styled by this or any other library
+func Strip(s string) string {
+	if !strings.ContainsRune(s, esc) && !strings.ContainsAny(s, c1) {
+		return s
+	}
+
+	var res strings.Builder
+	res.Grow(len(s))
+	for i := 0; i < len(s); {
+		if n := sequenceLength(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		res.WriteByte(s[i])
+		i++
+	}
+
+	return res.String()
+}
+
+// sequenceLength gives the number of bytes of the escape sequence at the start of s, 0 if there is none
+func sequenceLength(s string) int {
+	if s[0] == esc {
+		if len(s) == 1 {
+			return 1
+		}
+		switch s[1] {
+		case '[':
+			return controlLength(s, 2)
+		case ']', 'P', 'X', '^', '_':
+			return stringLength(s, 2)
+		}
+		// other sequences are made of some intermediate bytes followed by a final one
+		i := 1
+		for i < len(s) && s[i] >= 0x20 && s[i] <= 0x2f {
+			i++
+		}
+		if i < len(s) && s[i] >= 0x30 && s[i] <= 0x7e {
+			i++
+		}
+		return i
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	switch r {
+	case '\u009b':
+		return controlLength(s, size)
+	case '\u0090', '\u0098', '\u009d', '\u009e', '\u009f':
+		return stringLength(s, size)
+	}
+	return 0
+}
+
+// controlLength gives the length of a CSI sequence whose parameters start at i:
+// parameters and intermediate bytes are followed by a final one
+func controlLength(s string, i int) int {
+	for i < len(s) && s[i] >= 0x20 && s[i] <= 0x3f {
+		i++
+	}
+	if i < len(s) && s[i] >= 0x40 && s[i] <= 0x7e {
+		i++
+	}
+	return i
+}
+
+// stringLength gives the length of a sequence (like OSC) whose content starts at i and
+// goes on until the string terminator, BEL or the end of s
+func stringLength(s string, i int) int {
+	for ; i < len(s); i++ {
+		switch {
+		case s[i] == bel[0]:
+			return i + 1
+		case strings.HasPrefix(s[i:], st), strings.HasPrefix(s[i:], "\u009c"):
+			return i + 2
+		}
+	}
+	return i
+}
+
+// Sanitize makes the control characters of the string visible by escaping them like Go does
+// (ex. "\x1b[2J" becomes `\x1b[2J`), so that the string can be safely written on a terminal.
+// New lines and tabs are preserved, invalid UTF-8 bytes are escaped as well.
+// Use SanitizeInput to apply it automatically to the values that are painted
+func Sanitize(s string) string {
+	i := strings.IndexFunc(s, isUnsafe)
+	if i < 0 && utf8.ValidString(s) {
+		return s
+	} else if i < 0 {
+		i = 0
+	}
+
+	var res strings.Builder
+	res.Grow(len(s) + 8)
+	res.WriteString(s[:i])
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			res.WriteString(`\x`)
+			res.WriteString(hexByte(s[i]))
+		case isUnsafe(r):
+			res.WriteString(strings.Trim(strconv.QuoteRune(r), "'"))
+		default:
+			res.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+
+	return res.String()
+}
+
+// sanitized wraps a value so that it's sanitized after being formatted with any verb and flag
+type sanitized struct {
+	value any
+}
+
+func (s sanitized) Format(f fmt.State, verb rune) {
+	fmt.Fprint(f, Sanitize(fmt.Sprintf(fmt.FormatString(f, verb), s.value)))
+}
+
+func isUnsafe(r rune) bool {
+	return r < 0x20 && r != '\n' && r != '\t' || r >= 0x7f && r <= 0x9f
+}
+
+func hexByte(b byte) string {
+	const digits = "0123456789abcdef"
+	return string([]byte{digits[b>>4], digits[b&0xf]})
+}
@@ -0,0 +1,217 @@
+package brush_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleStrip() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	ok := brush.Paint(brush.Green, nil, "ok")
+	styled := brush.Join("status: ", ok.Link("https://example.com", ""))
+	fmt.Println(brush.Strip(styled.String()))
+	// Output: status: ok
+}
+
+func ExampleSanitize() {
+	fmt.Println(brush.Sanitize("user\x1b[2J\r\x07name"))
+	// Output: user\x1b[2J\r\aname
+}
+
+/* ---[ TESTS ]--- */
+
+func TestStrip(t *testing.T) {
+	var cases = []struct{ input, expected string }{
+		{"plain text", "plain text"},
+		{"\x1b[1;38;5;208mbold\x1b[0m", "bold"},
+		{"\x1b[2J\x1b[?25lhidden\x1b[?25h", "hidden"},
+		{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"\x1b]0;title\adone", "done"},
+		{"\x1bPdevice control\x1b\\x", "x"},
+		{"\x1b7saved\x1b8\x1bc", "saved"},
+		{"\x1b(Bcharset", "charset"},
+		{"\u009b31mred\u009b0m", "red"},
+		{"\u009d0;title\u009cdone", "done"},
+		{"unterminated\x1b]0;title", "unterminated"},
+		{"trailing\x1b", "trailing"},
+		{"new\nline\ttab", "new\nline\ttab"},
+		{"àèìòù\x1b[4m😀", "àèìòù😀"},
+	}
+
+	for _, c := range cases {
+		if got := brush.Strip(c.input); got != c.expected {
+			t.Errorf("Strip(%q) = %q, expected %q", c.input, got, c.expected)
+		}
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	var cases = []struct{ input, expected string }{
+		{"plain text", "plain text"},
+		{"new\nline\ttab", "new\nline\ttab"},
+		{"\x1b[31mred", `\x1b[31mred`},
+		{"over\rwrite\b", `over\rwrite\b`},
+		{"del\x7f", `del\x7f`},
+		{"c1\u009b31m", `c1\u009b31m`},
+		{"invalid\xff\x9bbytes", `invalid\xff\x9bbytes`},
+		{"àèìòù😀", "àèìòù😀"},
+	}
+
+	for _, c := range cases {
+		got := brush.Sanitize(c.input)
+		if got != c.expected {
+			t.Errorf("Sanitize(%q) = %q, expected %q", c.input, got, c.expected)
+		}
+		if again := brush.Sanitize(got); again != got {
+			t.Errorf("Sanitize is not idempotent on %q: %q", got, again)
+		}
+		if brush.Strip(got) != got {
+			t.Errorf("Sanitize(%q) = %q still contains escape sequences", c.input, got)
+		}
+	}
+}
+
+func TestSanitizeInput(t *testing.T) {
+	brush.DisableIfNotTTY = false
+	brush.SanitizeInput = true
+	defer func() { brush.SanitizeInput = false }()
+
+	var (
+		red     = brush.New(brush.Red, nil)
+		unsafe  = "evil\x1b]0;pwned\a"
+		escaped = `evil\x1b]0;pwned\a`
+	)
+
+	if got := red.Paint(unsafe).String(); got != "\x1b[31m"+escaped+"\x1b[0m" {
+		t.Errorf("Paint: unexpected %q", got)
+	}
+	if got := brush.Paintf(brush.Red, nil, "<%s>", unsafe).String(); !strings.Contains(got, escaped) {
+		t.Errorf("Paintf: unexpected %q", got)
+	}
+	if got := red.Embed(brush.Paint(brush.Blue, nil, "ok"), unsafe).String(); got != "\x1b[34mok\x1b[0m\x1b[31m"+escaped+"\x1b[0m" {
+		t.Errorf("Embed: unexpected %q", got)
+	}
+	if got := red.Highlight(unsafe, regexp.MustCompile("pwned")).String(); got != `evil\x1b]0;`+"\x1b[31mpwned\x1b[0m"+`\a` {
+		t.Errorf("Highlight: unexpected %q", got)
+	}
+	repl := func(string) string { return "\x1b[2J" }
+	if got := red.HighlightFunc("clear", regexp.MustCompile(".+"), repl).String(); got != "\x1b[31m"+`\x1b[2J`+"\x1b[0m" {
+		t.Errorf("HighlightFunc: unexpected %q", got)
+	}
+
+	// already painted values are not escaped twice
+	painted := red.Paint(unsafe)
+	if got := brush.Plain(red.Embed(painted, &painted)); got != escaped+escaped {
+		t.Errorf("Embed of painted: unexpected %q", got)
+	}
+}
+
+func TestRenderer_Sanitize(t *testing.T) {
+	var (
+		out     strings.Builder
+		r       = &brush.Renderer{Output: &out, Profile: brush.ProfileTrueColor, Sanitize: true}
+		red     = brush.NewStyle(brush.Red, nil)
+		unsafe  = "evil\x1b]0;pwned\a"
+		escaped = `evil\x1b]0;pwned\a`
+	)
+
+	if got := r.Paint(red, unsafe).String(); got != "\x1b[31m"+escaped+"\x1b[0m" {
+		t.Errorf("Paint: unexpected %q", got)
+	}
+	if got := brush.Plain(r.Embed(red, unsafe)); got != escaped {
+		t.Errorf("Embed: unexpected %q", got)
+	}
+	if got := brush.Plain(r.Highlight(red, unsafe, regexp.MustCompile("pwned"))); got != escaped {
+		t.Errorf("Highlight: unexpected %q", got)
+	}
+	r.Println(unsafe, r.Paint(red, "ok"))
+	if got := out.String(); got != escaped+" \x1b[31mok\x1b[0m\n" {
+		t.Errorf("Println: unexpected %q", got)
+	}
+
+	// the matches are searched before sanitizing
+	if got, want := r.Highlight(red, "x\x1b[2J", regexp.MustCompile("x")).String(), "\x1b[31mx\x1b[0m\\x1b[2J"; got != want {
+		t.Errorf("Highlight | want: %q got: %q", want, got)
+	}
+
+	out.Reset()
+	values := []any{"\x1b[2J", 3, r.Paint(red, "ok")}
+	r.Printf("%s|%03d|%s\n", values...)
+	if got, want := out.String(), "\\x1b[2J|003|\x1b[31mok\x1b[0m\n"; got != want {
+		t.Errorf("Printf | want: %q got: %q", want, got)
+	}
+	if values[0] != "\x1b[2J" {
+		t.Errorf("Printf changed the given values: %q", values)
+	}
+
+	out.Reset()
+	streamer := brush.NewStreamer(regexp.MustCompile("y"), r.New(red))
+	if err := streamer.Stream(context.Background(), &out, strings.NewReader("x\x1b[2Jy\n")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.String(), "x\\x1b[2J\x1b[31my\x1b[0m\n"; got != want {
+		t.Errorf("Stream | want: %q got: %q", want, got)
+	}
+
+	// the package level functions follow SanitizeInput
+	if got := brush.Paint(brush.Red, nil, unsafe).Markup(); !strings.Contains(got, unsafe) {
+		t.Errorf("Expected Paint not to be sanitized, got %q", got)
+	}
+}
+
+func TestLink_injection(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		url      = "https://example.com/\x1b\x1b[2J\a\u009c"
+		expected = "\x1b]8;;https://example.com/[2J\x1b\\x\x1b]8;;\x1b\\"
+	)
+
+	p := brush.Paint(brush.Red, nil, "x")
+	p.Link(url, "")
+	if got := brush.Strip(p.String()); got != "x" {
+		t.Errorf("Painted.Link: sequences injected in %q", p.String())
+	}
+
+	h := brush.Join("x")
+	if got := h.Link(url, "").String(); got != expected {
+		t.Errorf("Highlighted.Link | want: %q got: %q", expected, got)
+	}
+
+	if got := brush.NewStyle(brush.Red, nil).WithLink(url, "a;b:c").Paint("x").String(); !strings.Contains(got, "id=abc;") {
+		t.Errorf("Style.WithLink: unexpected id in %q", got)
+	}
+
+	if got := brush.MustMarkup("[link=https://example.com/\x1b[2J]x[/]").String(); got != expected {
+		t.Errorf("Markup | want: %q got: %q", expected, got)
+	}
+}
+
+/* ---[ BENCHMARKS ]--- */
+
+func BenchmarkStrip(b *testing.B) {
+	p := brush.Paint(brush.Red, nil, "error")
+	s := strings.Repeat(p.Link("https://example.com", "").String()+" plain text ", 50)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		brush.Strip(s)
+	}
+}
+
+func BenchmarkSanitize(b *testing.B) {
+	s := strings.Repeat("some user input\x1b[2J ", 50)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		brush.Sanitize(s)
+	}
+}
@@ -0,0 +1,219 @@
+package brush
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Spinner is a sequence of frames shown in loop to represent a task in progress
+type Spinner []string
+
+// Built-in spinners
+var (
+	DotsSpinner = Spinner{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	LineSpinner = Spinner{"-", "\\", "|", "/"}
+)
+
+type taskState uint8
+
+const (
+	running taskState = iota
+	succeeded
+	failed
+)
+
+// Task is a line of a StatusBoard, it's safe to use from multiple goroutines
+type Task struct {
+	board *StatusBoard
+	label any
+	err   error
+	state taskState
+}
+
+// StatusBoard shows the status of multiple concurrent tasks, one per line, each one with a spinner
+// while running and a colored symbol when completed. It's safe to use from multiple goroutines.
+// If the Output is interactive (see Disable and DisableIfNotTTY) the lines are redrawn in place,
+// otherwise a plain line is appended every time a task starts or completes
+type StatusBoard struct {
+	Output       io.Writer     // Output is where the board will be written, os.Stdout if nil
+	Spinner      Spinner       // Spinner shown by running tasks, DotsSpinner if nil
+	Interval     time.Duration // Interval between two frames of the spinner, 100ms if zero
+	SpinnerBrush Painter       // SpinnerBrush is used to paint the spinner, the "info" role of the DefaultTheme if nil
+	SuccessBrush Painter       // SuccessBrush is used to paint the "✔", the "success" role of the DefaultTheme if nil
+	FailureBrush Painter       // FailureBrush is used to paint the "✖", the "error" role of the DefaultTheme if nil
+
+	tasks []*Task
+	drawn int
+	frame int
+	done  chan struct{}
+	wg    sync.WaitGroup
+	mu    sync.Mutex
+}
+
+// NewStatusBoard creates a new board that will be written on the given output
+func NewStatusBoard(output io.Writer) *StatusBoard {
+	return &StatusBoard{Output: output}
+}
+
+// Add starts a new task with the given label and shows it on the board
+func (b *StatusBoard) Add(label any) *Task {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t := &Task{board: b, label: label}
+	b.tasks = append(b.tasks, t)
+
+	if !interactive(b.output()) {
+		fmt.Fprintln(b.output(), "•", extractContent(label))
+		return t
+	}
+
+	if b.done == nil {
+		b.done = make(chan struct{})
+		b.wg.Add(1)
+		go b.animate()
+	}
+	b.redraw()
+	return t
+}
+
+// Stop shows the final state of the board and stops the animation of the spinners.
+// The board should not be used after being stopped
+func (b *StatusBoard) Stop() {
+	b.mu.Lock()
+	if b.done != nil {
+		close(b.done)
+	}
+	b.mu.Unlock()
+
+	b.wg.Wait()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.done != nil {
+		b.redraw()
+		b.done = nil
+	}
+}
+
+func (b *StatusBoard) animate() {
+	defer b.wg.Done()
+
+	interval := b.Interval
+	if interval <= 0 {
+		interval = 100 * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.done:
+			return
+		case <-ticker.C:
+			b.mu.Lock()
+			b.frame++
+			b.redraw()
+			b.mu.Unlock()
+		}
+	}
+}
+
+func (b *StatusBoard) output() io.Writer {
+	if b.Output == nil {
+		return os.Stdout
+	}
+	return b.Output
+}
+
+func (b *StatusBoard) spinner() Spinner {
+	if len(b.Spinner) == 0 {
+		return DotsSpinner
+	}
+	return b.Spinner
+}
+
+func painterOr(p Painter, role string) Painter {
+	if p == nil {
+		return DefaultTheme.Brush(role)
+	}
+	return p
+}
+
+// redraw moves the cursor back to the first line of the board and writes all the tasks again
+func (b *StatusBoard) redraw() {
+	var parts []any
+	if b.drawn > 0 {
+		parts = append(parts, CursorPreviousLine(b.drawn).sequence)
+	}
+	for _, t := range b.tasks {
+		parts = append(parts, t.render(), ClearLineRight().sequence, "\n")
+	}
+
+	fmt.Fprint(b.output(), Join(parts...))
+	b.drawn = len(b.tasks)
+}
+
+func (t *Task) render() Highlighted {
+	var symbol Painted
+	switch t.state {
+	case succeeded:
+		symbol = painterOr(t.board.SuccessBrush, "success").Paint("✔")
+	case failed:
+		symbol = painterOr(t.board.FailureBrush, "error").Paint("✖")
+	default:
+		spinner := t.board.spinner()
+		symbol = painterOr(t.board.SpinnerBrush, "info").Paint(spinner[t.board.frame%len(spinner)])
+	}
+
+	line := Join(symbol, " ", t.label)
+	if t.err != nil {
+		line.Append(": ", t.err)
+	}
+	return line
+}
+
+// Update changes the label of the task
+func (t *Task) Update(label any) {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+
+	t.label = label
+	if interactive(t.board.output()) {
+		t.board.redraw()
+	}
+}
+
+// Done marks the task as successfully completed
+func (t *Task) Done() {
+	t.complete(succeeded, nil)
+}
+
+// Fail marks the task as failed because of the given error (can be nil)
+func (t *Task) Fail(err error) {
+	t.complete(failed, err)
+}
+
+func (t *Task) complete(state taskState, err error) {
+	t.board.mu.Lock()
+	defer t.board.mu.Unlock()
+
+	t.state, t.err = state, err
+	if interactive(t.board.output()) {
+		t.board.redraw()
+		return
+	}
+
+	symbol := "✔"
+	if state == failed {
+		symbol = "✖"
+	}
+	line := symbol + " " + extractContent(t.label)
+	if err != nil {
+		line += ": " + err.Error()
+	}
+	fmt.Fprintln(t.board.output(), line)
+}
@@ -0,0 +1,84 @@
+package brush_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleStatusBoard() {
+	brush.DisableIfNotTTY = true // default value, a plain line is appended on outputs that are not a terminal
+
+	var (
+		log   strings.Builder
+		board = brush.NewStatusBoard(&log)
+		wg    sync.WaitGroup
+	)
+
+	for i, name := range []string{"download", "build", "test"} {
+		task := board.Add(name)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if time.Sleep(time.Duration(i) * 10 * time.Millisecond); name == "test" {
+				task.Fail(errors.New("3 failed"))
+			} else {
+				task.Done()
+			}
+		}()
+	}
+	wg.Wait()
+	board.Stop()
+	fmt.Print(log.String())
+
+	// Unordered output:
+	// • download
+	// • build
+	// • test
+	// ✔ download
+	// ✔ build
+	// ✖ test: 3 failed
+}
+
+/* ---[ TESTS ]--- */
+
+func TestStatusBoard(t *testing.T) {
+	brush.DisableIfNotTTY = false
+	defer func() { brush.DisableIfNotTTY = true }()
+
+	var (
+		out     strings.Builder
+		board   = brush.StatusBoard{Output: &out, Spinner: brush.LineSpinner, Interval: time.Hour}
+		success = brush.New(brush.Green, nil)
+		failure = brush.New(brush.Red, nil)
+		spinner = brush.New(brush.Cyan, nil)
+	)
+	success.Disable, failure.Disable, spinner.Disable = false, false, true
+	board.SuccessBrush, board.FailureBrush, board.SpinnerBrush = success, failure, spinner
+
+	download := board.Add("download")
+	build := board.Add("build")
+	build.Update("build (2/3)")
+	download.Done()
+	build.Fail(errors.New("oops"))
+	board.Stop()
+
+	start := strings.LastIndex(out.String(), "\x1b[2F")
+	if start < 0 {
+		t.Fatalf("Expected the board to be redrawn in place, got: %q", out.String())
+	}
+	last := out.String()[start:]
+	want := "\x1b[2F\x1b[32m✔\x1b[0m download\x1b[K\n\x1b[31m✖\x1b[0m build (2/3): oops\x1b[K\n"
+	assert(t, "Final frame", last, want)
+
+	if !strings.HasPrefix(out.String(), "- download\x1b[K\n\x1b[1F- download\x1b[K\n- build\x1b[K\n") {
+		t.Errorf("Unexpected first frames: %q", out.String())
+	}
+}
@@ -0,0 +1,152 @@
+package brush
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Streamer highlights the parts of a stream of text that match a pattern, writing the result while reading
+// with bounded memory, so it can be used on huge files or endless streams (like "tail -f").
+// By default the text is searched line by line (lines longer than ChunkSize are split),
+// set Overlap to also find matches that span multiple lines. The text is sanitized if the Brush
+// follows a renderer with Sanitize set (like the Default renderer when SanitizeInput is true)
+type Streamer struct {
+	Find      *regexp.Regexp // Find is the pattern to highlight
+	Brush     Painter        // Brush is used to paint the matches
+	ChunkSize int            // ChunkSize is the max number of bytes read at once, 64KiB if zero
+	Overlap   int            // Overlap is the max length of a match that spans multiple lines, 0 to search line by line
+}
+
+// NewStreamer creates a new streamer that highlights the matches of find with the given brush
+func NewStreamer(find *regexp.Regexp, brush Painter) *Streamer {
+	return &Streamer{Find: find, Brush: brush}
+}
+
+func (s *Streamer) chunkSize() int {
+	if s.ChunkSize <= 0 {
+		return 64 * 1024
+	}
+	return s.ChunkSize
+}
+
+// Stream reads from src until EOF writing on dst the text with the matches highlighted.
+// It stops early if the context is canceled, giving back its error.
+// Be aware that a read that is blocked will not be interrupted, so it might consume some input after the cancellation
+func (s *Streamer) Stream(ctx context.Context, dst io.Writer, src io.Reader) error {
+	type chunk struct {
+		data []byte
+		err  error
+	}
+	var (
+		chunks  = make(chan chunk)
+		stop    = make(chan struct{})
+		pending []byte
+	)
+	defer close(stop)
+
+	go func() {
+		for {
+			buf := make([]byte, s.chunkSize())
+			n, err := src.Read(buf)
+			select {
+			case chunks <- chunk{buf[:n], err}:
+			case <-stop:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		var c chunk
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case c = <-chunks:
+		}
+
+		pending = append(pending, c.data...)
+		eof := c.err == io.EOF
+		if c.err != nil && !eof {
+			return c.err
+		}
+
+		done, err := s.flush(dst, pending, eof)
+		if err != nil {
+			return err
+		}
+		pending = append(pending[:0], pending[done:]...)
+
+		if eof {
+			return nil
+		}
+	}
+}
+
+// flush writes the part of the pending text that can be highlighted without knowing what comes next,
+// giving back its size
+func (s *Streamer) flush(dst io.Writer, pending []byte, eof bool) (int, error) {
+	var cut, end = len(pending), len(pending)
+	switch {
+	case eof:
+	case s.Overlap > 0:
+		cut = max(0, len(pending)-s.Overlap)
+	case bytes.IndexByte(pending, '\n') >= 0:
+		cut = bytes.LastIndexByte(pending, '\n') + 1
+		end = cut
+	case len(pending) <= s.chunkSize():
+		// the line is not complete yet, a line is split only if it's longer than ChunkSize
+		cut = 0
+	}
+	// a character is never split, so that it can be sanitized
+	for cut > 0 && cut < len(pending) && !utf8.RuneStart(pending[cut]) {
+		cut--
+	}
+	if cut == 0 {
+		return 0, nil
+	}
+
+	var (
+		text     = string(pending[:end])
+		res      = Highlighted{}
+		content  strings.Builder
+		last     int
+		style    = s.Brush.styling()
+		sanitize = s.Brush.settings().Sanitize
+		clean    = func(part string) string {
+			if sanitize {
+				return Sanitize(part)
+			}
+			return part
+		}
+	)
+	content.Grow(cut)
+
+	// the matches are searched on the original text and then each part is sanitized
+	for _, indexes := range s.Find.FindAllStringIndex(text, -1) {
+		if indexes[0] >= cut {
+			break
+		}
+		if indexes[1] > cut {
+			cut = indexes[1]
+		}
+		content.WriteString(clean(text[last:indexes[0]]))
+		from := content.Len()
+		content.WriteString(clean(text[indexes[0]:indexes[1]]))
+		if style != nil && from < content.Len() {
+			res.addSections(section{from: from, to: content.Len(), style: style})
+		}
+		last = indexes[1]
+	}
+	content.WriteString(clean(text[last:cut]))
+	res.content = content.String()
+
+	_, err := res.WriteTo(dst)
+	return cut, err
+}
@@ -0,0 +1,118 @@
+package brush_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleStreamer() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	var (
+		logs     = strings.NewReader("INFO started\nERROR disk full\nINFO stopped\n")
+		streamer = brush.NewStreamer(regexp.MustCompile("ERROR"), brush.New(brush.Red, nil))
+	)
+
+	if err := streamer.Stream(context.Background(), os.Stdout, logs); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// INFO started
+	// [31mERROR[0m disk full
+	// INFO stopped
+}
+
+/* ---[ TESTS ]--- */
+
+func TestStreamer(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		marker = brush.New(brush.Black, brush.UseColor(brush.Yellow))
+		input  = "first line\nsecond: the end\nof the line\nthe end"
+	)
+
+	var cases = []struct {
+		name     string
+		streamer brush.Streamer
+		expected string
+	}{
+		{
+			"line by line",
+			brush.Streamer{Find: regexp.MustCompile(`the end`), Brush: marker, ChunkSize: 32},
+			"first line\nsecond: \x1b[30;43mthe end\x1b[0m\nof the line\n\x1b[30;43mthe end\x1b[0m",
+		},
+		{
+			"line by line without multi-line matches",
+			brush.Streamer{Find: regexp.MustCompile(`end\nof`), Brush: marker, ChunkSize: 16},
+			input,
+		},
+		{
+			"overlap",
+			brush.Streamer{Find: regexp.MustCompile(`end\nof`), Brush: marker, ChunkSize: 3, Overlap: 6},
+			"first line\nsecond: the \x1b[30;43mend\nof\x1b[0m the line\nthe end",
+		},
+	}
+
+	for _, c := range cases {
+		var out strings.Builder
+		src := iotest.OneByteReader(strings.NewReader(input))
+		if err := c.streamer.Stream(context.Background(), &out, src); err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+		} else if got := out.String(); got != c.expected {
+			t.Errorf("%s | want: %q got: %q", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestStreamer_fullChunks(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		out      strings.Builder
+		streamer = brush.Streamer{Find: regexp.MustCompile(`ERROR`), Brush: brush.New(brush.Red, nil), ChunkSize: 8}
+		expected = "xxxxx\x1b[31mERROR\x1b[0m\n\x1b[31mERROR\x1b[0m\n"
+	)
+
+	// every read fills the chunk, so the first match is split between two of them
+	if err := streamer.Stream(context.Background(), &out, strings.NewReader("xxxxxERROR\nERROR\n")); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	if got := out.String(); got != expected {
+		t.Errorf("want: %q got: %q", expected, got)
+	}
+}
+
+func TestStreamer_cancel(t *testing.T) {
+	var (
+		r, w        = io.Pipe()
+		out         strings.Builder
+		ctx, cancel = context.WithCancel(context.Background())
+		streamer    = brush.NewStreamer(regexp.MustCompile("x"), brush.New(brush.Red, nil))
+		done        = make(chan error)
+	)
+	defer w.Close()
+
+	go func() { done <- streamer.Stream(ctx, &out, r) }()
+	cancel()
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context canceled error, got: %v", err)
+	}
+
+	failing := iotest.ErrReader(errors.New("broken"))
+	if err := streamer.Stream(context.Background(), &out, failing); err == nil || err.Error() != "broken" {
+		t.Errorf("Expected read error, got: %v", err)
+	}
+}
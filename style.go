@@ -0,0 +1,133 @@
+package brush
+
+// Style holds the colors, attributes and link used to paint something, independently of a Brush and its ColorType.
+// Styles are comparable: two styles are equal if they produce the same output.
+// The zero value is the style that does not apply anything
+type Style struct {
+	style
+}
+
+// NewStyle creates a new style with the given font and background color (nil for the default one)
+func NewStyle[color ColorType](font color, background Optional[color]) Style {
+	return Style{serialize(font, background)}
+}
+
+// Style gives back the current style of the brush
+func (b Brush[color]) Style() Style {
+	return Style{b.extract()}
+}
+
+// Style gives back the style of the painted item
+func (p Painted) Style() Style {
+	return Style{p.style}
+}
+
+// Foreground gives the font color of the style, ok is false if it uses the default one
+func (s Style) Foreground() (c Color, ok bool) {
+	return decodeColor(s.foreground)
+}
+
+// Background gives the background color of the style, ok is false if it uses the default one
+func (s Style) Background() (c Color, ok bool) {
+	return decodeColor(s.background)
+}
+
+// Attributes gives the attributes of the style
+func (s Style) Attributes() Attribute {
+	return s.attributes
+}
+
+// WithAttributes gives back the style with the given attributes added to the existing ones
+func (s Style) WithAttributes(attrs Attribute) Style {
+	s.attributes |= attrs
+	return s
+}
+
+// WithLink gives back the style that makes the content a clickable link, see the Link method of Painted
+func (s Style) WithLink(url, id string) Style {
+	s.link, s.linkID = safeLink(url, id)
+	return s
+}
+
+// Override gives back the style with the given one on top: the colors and link that are set
+// in over replace the ones of s and the attributes are combined
+func (s Style) Override(over Style) Style {
+	return Style{s.merge(over.style)}
+}
+
+// Merge gives back the style with the given one below: the colors and link that are not set
+// in s are taken from other and the attributes are combined
+func (s Style) Merge(other Style) Style {
+	return other.Override(s)
+}
+
+// Equal tells if the two styles are the same, like using ==
+func (s Style) Equal(other Style) bool {
+	return s == other
+}
+
+// IsZero tells if the style does not apply anything
+func (s Style) IsZero() bool {
+	return s == Style{}
+}
+
+// SGR gives the special sequence that enables the style (without the one for links), empty if there is nothing to enable.
+// The sequence to reset all styles is "\x1b[0m"
+func (s Style) SGR() string {
+	return s.sgr()
+}
+
+// String gives the style written as a markup tag (see Markup), empty if there is nothing to apply
+func (s Style) String() string {
+	return s.tag()
+}
+
+// Apply gives back the content surrounded by the special sequences of the style,
+// the content is left as it is if styling is disabled (see Disable and DisableIfNotTTY)
+func (s Style) Apply(content string) string {
+	r := defaults()
+	if r.Disable {
+		return content
+	}
+	return r.adapt(s.style).apply(content)
+}
+
+// Paint some values (joined without separator) with the style, like the Paint method of Brush
+func (s Style) Paint(values ...any) Painted {
+	p := Paint(Color{}, nil, values...)
+	p.style = defaults().adapt(s.style)
+	return p
+}
+
+// Embed joins the values using the style for the ones that are not styled, like the Embed method of Brush
+func (s Style) Embed(values ...any) Highlighted {
+	r := defaults()
+	return embed(s.styling(), r.Disable, r.Sanitize, values)
+}
+
+func (s Style) styling() *style {
+	r := defaults()
+	if r.Disable {
+		return nil
+	}
+	adapted := r.adapt(s.style)
+	return &adapted
+}
+
+// settings gives the renderer that the style follows, that is always the Default one
+func (s Style) settings() Renderer {
+	return defaults()
+}
+
+// Brush creates a new brush that uses the colors, attributes and link of the style as default
+func (s Style) Brush() Brush[Color] {
+	return s.brush()
+}
+
+// StyleAt gives the style of the content at the given byte offset, the zero Style if it has none
+func (h Highlighted) StyleAt(offset int) Style {
+	if sec := h.sectionAt(offset); sec != nil && sec.style != nil {
+		return Style{*sec.style}
+	}
+	return Style{}
+}
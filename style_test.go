@@ -0,0 +1,78 @@
+package brush_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleStyle() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	var (
+		base    = brush.NewStyle(brush.White, brush.UseColor(brush.Blue))
+		warning = brush.NewStyle(brush.ExtendedANSIColor(208), nil).WithAttributes(brush.Bold)
+		both    = base.Override(warning)
+	)
+
+	fmt.Println(both)
+	fmt.Printf("%q\n", both.SGR())
+	fmt.Printf("%q\n", both.Apply("careful"))
+	// Output:
+	// [bold 208 on blue]
+	// "\x1b[1;38;5;208;44m"
+	// "\x1b[1;38;5;208;44mcareful\x1b[0m"
+}
+
+/* ---[ TESTS ]--- */
+
+func TestStyle(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		red    = brush.NewStyle(brush.Red, nil)
+		onBlue = brush.NewStyle(brush.ToColor(brush.Blue), brush.UseColor(brush.ToColor(brush.Yellow)))
+	)
+
+	if !red.Equal(brush.New(brush.Red, nil).Style()) || red != brush.Paint(brush.Red, nil, "x").Style() {
+		t.Error("Expected styles from the same colors to be equal")
+	}
+	if red.Equal(onBlue) || (brush.Style{}).IsZero() == false || red.IsZero() {
+		t.Error("Unexpected equality")
+	}
+
+	merged := red.Merge(onBlue)
+	if fg, _ := merged.Foreground(); fg.String() != "red" {
+		t.Errorf("Merge should keep the font color, got %s", fg)
+	}
+	if bg, ok := merged.Background(); !ok || bg.String() != "yellow" {
+		t.Errorf("Merge should take the missing background, got %s", bg)
+	}
+
+	overridden := red.Override(onBlue)
+	if fg, _ := overridden.Foreground(); fg.String() != "blue" {
+		t.Errorf("Override should replace the font color, got %s", fg)
+	}
+
+	if _, ok := red.Background(); ok {
+		t.Error("Expected default background")
+	}
+
+	b := overridden.WithAttributes(brush.Italic).Brush()
+	if got, want := b.Paint("x").String(), "\x1b[3;34;43mx\x1b[0m"; got != want {
+		t.Errorf("Brush | want: %q got: %q", want, got)
+	}
+
+	if got, want := red.Embed("a", brush.Paint(brush.Green, nil, "b")).String(), "\x1b[31ma\x1b[0m\x1b[32mb\x1b[0m"; got != want {
+		t.Errorf("Embed | want: %q got: %q", want, got)
+	}
+
+	table := brush.NewTable("name")
+	table.HeaderBrush = red.WithAttributes(brush.Bold)
+	if got, want := table.String(), "\x1b[1;31mname\x1b[0m"; got != want {
+		t.Errorf("Style used as Painter | want: %q got: %q", want, got)
+	}
+}
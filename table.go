@@ -0,0 +1,307 @@
+package brush
+
+// Column lets you customize all the cells of a column of a Table
+type Column struct {
+	Align    Alignment // Align is the horizontal alignment of the content of the cells
+	Brush    Painter   // Brush is used to paint the cells of the column (header excluded)
+	MaxWidth int       // MaxWidth is the maximum width of the content of the cells, 0 means no limit
+	Wrap     bool      // Wrap the content that is too wide on multiple lines instead of truncating it
+}
+
+// Row represents a row of a Table with an optional brush used for all of its cells
+type Row struct {
+	Cells []any
+	Brush Painter
+}
+
+// Cell lets you use a specific brush for a single cell of a Table
+type Cell struct {
+	Value any
+	Brush Painter
+}
+
+// Table shows some values organized in rows and columns, the cells can be Painted, Highlighted,
+// Cell or any other value, their width is measured ignoring the styling so they will always be aligned.
+// When the same cell is affected by multiple brushes, the styling is layered (from top to bottom):
+// the one of the value itself, the one of the Cell, of the Row, of the Column and lastly of the Stripes.
+// For example stripes with different background colors and columns with different font colors can be combined
+type Table struct {
+	Header      []any
+	Rows        []Row
+	Columns     []Column
+	HeaderBrush Painter
+	Stripes     []Painter // Stripes are alternately used to paint the rows (ex. zebra striping)
+	Border      *Border   // Border used around and between the cells, nil means none
+	BorderBrush Painter
+	Separator   string // Separator is used between the columns when there is no Border, if empty "  " is used
+	MaxWidth    int    // MaxWidth is the maximum width of the whole table, 0 means no limit
+}
+
+// NewTable creates a new table with the given header (can be omitted)
+func NewTable(header ...any) *Table {
+	return &Table{Header: header}
+}
+
+// AddRow adds a row with the given cells and gives back the same (now modified) table
+func (t *Table) AddRow(cells ...any) *Table {
+	t.Rows = append(t.Rows, Row{Cells: cells})
+	return t
+}
+
+// AddStyledRow adds a row with the given cells painted using the brush and gives back the same (now modified) table
+func (t *Table) AddStyledRow(brush Painter, cells ...any) *Table {
+	t.Rows = append(t.Rows, Row{Cells: cells, Brush: brush})
+	return t
+}
+
+// layerOf gives the style of the painter if not disabled
+func layerOf(p Painter) *style {
+	if p == nil {
+		return nil
+	}
+	return p.styling()
+}
+
+type tableCell struct {
+	lines  []Highlighted
+	layers []*style
+}
+
+type tableRow struct {
+	cells  []tableCell
+	layers []*style // layers used for the space between the cells
+}
+
+func (t Table) column(i int) (c Column) {
+	if i < len(t.Columns) {
+		c = t.Columns[i]
+	}
+	return
+}
+
+func (t Table) newCell(value any, layers ...*style) (c tableCell) {
+	if cell, ok := value.(Cell); ok {
+		value = cell.Value
+		layers = append([]*style{layerOf(cell.Brush)}, layers...)
+	}
+
+	if value != nil {
+		c.lines = toHighlighted(value).lines()
+	}
+	c.layers = layers
+	return
+}
+
+// Render gives back the table as an Highlighted item
+func (t Table) Render() Highlighted {
+	var (
+		columns = len(t.Header)
+		rows    []tableRow
+	)
+
+	for _, r := range t.Rows {
+		columns = max(columns, len(r.Cells))
+	}
+	columns = max(columns, len(t.Columns))
+	if columns == 0 {
+		return Highlighted{}
+	}
+
+	if len(t.Header) > 0 {
+		header := make([]tableCell, columns)
+		for i := range header {
+			var value any
+			if i < len(t.Header) {
+				value = t.Header[i]
+			}
+			header[i] = t.newCell(value, layerOf(t.HeaderBrush))
+		}
+		rows = append(rows, tableRow{header, []*style{layerOf(t.HeaderBrush)}})
+	}
+
+	for n, r := range t.Rows {
+		var stripe *style
+		if len(t.Stripes) > 0 {
+			stripe = layerOf(t.Stripes[n%len(t.Stripes)])
+		}
+
+		row := make([]tableCell, columns)
+		for i := range row {
+			var value any
+			if i < len(r.Cells) {
+				value = r.Cells[i]
+			}
+			row[i] = t.newCell(value, layerOf(r.Brush), layerOf(t.column(i).Brush), stripe)
+		}
+		rows = append(rows, tableRow{row, []*style{layerOf(r.Brush), stripe}})
+	}
+
+	var (
+		widths = t.widths(rows, columns)
+		parts  []any
+	)
+
+	for n, row := range rows {
+		if t.Border != nil {
+			switch {
+			case n == 0:
+				parts = append(parts, t.borderLine(t.Border.TopLeft, t.Border.Top, t.Border.TopJoin, t.Border.TopRight, widths), "\n")
+			case n == 1 && len(t.Header) > 0:
+				parts = append(parts, t.borderLine(t.Border.LeftJoin, t.Border.Top, t.Border.Cross, t.Border.RightJoin, widths), "\n")
+			}
+		}
+		parts = append(parts, t.renderRow(row, widths))
+		if n < len(rows)-1 || t.Border != nil {
+			parts = append(parts, "\n")
+		}
+	}
+
+	if t.Border != nil {
+		parts = append(parts, t.borderLine(t.Border.BottomLeft, t.Border.Bottom, t.Border.BottomJoin, t.Border.BottomRight, widths))
+	}
+
+	return Join(parts...)
+}
+
+// widths calculates the width of each column so that the table fits the MaxWidth
+func (t Table) widths(rows []tableRow, columns int) []int {
+	widths := make([]int, columns)
+	for _, row := range rows {
+		for i, cell := range row.cells {
+			for _, line := range cell.lines {
+				widths[i] = max(widths[i], textWidth(line.content))
+			}
+		}
+	}
+
+	for i := range widths {
+		if limit := t.column(i).MaxWidth; limit > 0 {
+			widths[i] = min(widths[i], limit)
+		}
+	}
+
+	if t.MaxWidth <= 0 {
+		return widths
+	}
+
+	available := t.MaxWidth - textWidth(t.separator())*(columns-1)
+	if t.Border != nil {
+		available = t.MaxWidth - (columns + 1) - 2*columns
+	}
+
+	for total := sum(widths); total > available; total-- {
+		widest := 0
+		for i := range widths {
+			if widths[i] > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= 1 {
+			break
+		}
+		widths[widest]--
+	}
+
+	return widths
+}
+
+func sum(nums []int) (total int) {
+	for _, n := range nums {
+		total += n
+	}
+	return
+}
+
+func (t Table) separator() string {
+	if t.Separator == "" {
+		return "  "
+	}
+	return t.Separator
+}
+
+func (t Table) borderLine(left, line, join, right string, widths []int) any {
+	padded := make([]int, len(widths))
+	for i, w := range widths {
+		padded[i] = w + 2
+	}
+
+	s := t.Border.horizontal(left, line, join, right, padded)
+	if t.BorderBrush == nil {
+		return s
+	}
+	return t.BorderBrush.Paint(s)
+}
+
+// renderRow gives back all the lines of a row, fitting the given widths
+func (t Table) renderRow(row tableRow, widths []int) Highlighted {
+	var (
+		cells  = make([][]Highlighted, len(row.cells))
+		height int
+	)
+
+	for i, cell := range row.cells {
+		for _, line := range cell.lines {
+			if t.column(i).Wrap {
+				cells[i] = append(cells[i], line.wrap(widths[i])...)
+			} else {
+				cells[i] = append(cells[i], line.truncate(widths[i]))
+			}
+		}
+		height = max(height, len(cells[i]))
+	}
+
+	var parts []any
+	for n := 0; n < max(height, 1); n++ {
+		if n > 0 {
+			parts = append(parts, "\n")
+		}
+
+		if t.Border != nil {
+			parts = append(parts, t.borderPiece(t.Border.Left))
+		}
+		for i, cell := range row.cells {
+			var line Highlighted
+			if n < len(cells[i]) {
+				line = cells[i][n]
+			}
+
+			line = line.pad(widths[i], t.column(i).Align)
+			if t.Border != nil {
+				line = Join(" ", line, " ")
+			}
+			for _, layer := range cell.layers {
+				line = line.layer(layer)
+			}
+
+			if i > 0 {
+				if t.Border != nil {
+					parts = append(parts, t.borderPiece(t.Border.Left))
+				} else {
+					separator := Join(t.separator())
+					for _, layer := range row.layers {
+						separator = separator.layer(layer)
+					}
+					parts = append(parts, separator)
+				}
+			}
+			parts = append(parts, line)
+		}
+		if t.Border != nil {
+			parts = append(parts, t.borderPiece(t.Border.Right))
+		}
+	}
+
+	return Join(parts...)
+}
+
+func (t Table) borderPiece(s string) any {
+	if t.BorderBrush == nil {
+		return s
+	}
+	return t.BorderBrush.Paint(s)
+}
+
+// String gives the table with the special sequences that will apply the styling
+func (t Table) String() string {
+	return t.Render().String()
+}
@@ -0,0 +1,131 @@
+package brush_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleTable() {
+	table := brush.NewTable("Name", "Lang", "Stars")
+	table.Border = &brush.RoundedBorder
+	table.Columns = []brush.Column{2: {Align: brush.AlignRight}}
+
+	table.AddRow("brush", "Go", 42).
+		AddRow("termenv", "Go", 1500).
+		AddRow("lipgloss", "Go", 8000)
+
+	fmt.Println(table)
+
+	// Output:
+	// ╭──────────┬──────┬───────╮
+	// │ Name     │ Lang │ Stars │
+	// ├──────────┼──────┼───────┤
+	// │ brush    │ Go   │    42 │
+	// │ termenv  │ Go   │  1500 │
+	// │ lipgloss │ Go   │  8000 │
+	// ╰──────────┴──────┴───────╯
+}
+
+func ExampleTable_Stripes() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	table := brush.NewTable()
+	table.Stripes = []brush.Painter{
+		brush.New(brush.White, brush.UseColor(brush.Black)),
+		brush.New(brush.White, brush.UseColor(brush.BrightBlack)),
+	}
+	table.Columns = []brush.Column{{Brush: brush.New(brush.Cyan, nil)}}
+	table.AddRow("a", 1).AddRow("b", 2)
+
+	fmt.Println(table.Render().Markup())
+
+	// Output:
+	// [cyan on black]a[/][white on black]  [/][white on black]1[/]
+	// [cyan on bright-black]b[/][white on bright-black]  [/][white on bright-black]2[/]
+}
+
+func ExampleWidth() {
+	fmt.Println(brush.Width(brush.Paint(brush.Red, nil, "hello")))
+	fmt.Println(brush.Width("日本語"))
+	fmt.Println(brush.Width("multi\nline"))
+
+	// Output:
+	// 5
+	// 6
+	// 5
+}
+
+/* ---[ TESTS ]--- */
+
+func TestTable_Render(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	table := brush.NewTable("#", "Description")
+	table.Columns = []brush.Column{{Align: brush.AlignCenter}, {MaxWidth: 11}}
+	table.AddRow(1, "short").AddRow(10, "something too long").AddRow(100, "multi\nline")
+
+	assert(t, "Truncating", table.String(), strings.Join([]string{
+		" #   Description",
+		" 1   short      ",
+		"10   something …",
+		"100  multi      ",
+		"     line       ",
+	}, "\n"))
+
+	table.Columns[1].Wrap = true
+	assert(t, "Wrapping", table.String(), strings.Join([]string{
+		" #   Description",
+		" 1   short      ",
+		"10   something  ",
+		"     too long   ",
+		"100  multi      ",
+		"     line       ",
+	}, "\n"))
+
+	table = brush.NewTable("key", "value")
+	table.Border, table.MaxWidth = &brush.ASCIIBorder, 20
+	table.AddRow("a", "a very long value that does not fit")
+	assert(t, "Fitting max width", table.String(), strings.Join([]string{
+		"+-----+------------+",
+		"| key | value      |",
+		"+-----+------------+",
+		"| a   | a very lo… |",
+		"+-----+------------+",
+	}, "\n"))
+
+	assert(t, "Empty table", brush.NewTable().String(), "")
+}
+
+func TestTable_Brushes(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	var (
+		red    = brush.New(brush.Red, nil)
+		bold   = brush.New(brush.Color{}, nil)
+		border = brush.New(brush.BrightBlack, nil)
+		table  = brush.NewTable("h")
+	)
+	bold.UseAttributes(bold.Attributes | brush.Bold)
+	red.Disable, bold.Disable, border.Disable = false, false, false
+
+	table.HeaderBrush, table.BorderBrush, table.Border = bold, border, &brush.SingleBorder
+	table.AddStyledRow(red, "r").
+		AddRow(brush.Cell{Value: "c", Brush: red}).
+		AddRow(brush.Paint(brush.Green, nil, "p"))
+
+	want := strings.Join([]string{
+		"[bright-black]┌───┐[/]",
+		"[bright-black]│[/][bold] h [/][bright-black]│[/]",
+		"[bright-black]├───┤[/]",
+		"[bright-black]│[/][red] r [/][bright-black]│[/]",
+		"[bright-black]│[/][red] c [/][bright-black]│[/]",
+		"[bright-black]│[/] [green]p[/] [bright-black]│[/]",
+		"[bright-black]└───┘[/]",
+	}, "\n")
+	assert(t, "Styled table", table.Render().Markup(), want)
+}
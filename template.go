@@ -0,0 +1,76 @@
+package brush
+
+import (
+	"regexp"
+	"text/template"
+)
+
+// TemplateFuncs gives the functions to paint values inside a text/template using the DefaultTheme.
+// To use them with html/template, convert the result: htmltemplate.FuncMap(brush.TemplateFuncs()).
+// See the TemplateFuncs method of Theme for the list of functions
+func TemplateFuncs() template.FuncMap {
+	return DefaultTheme.TemplateFuncs()
+}
+
+// TemplateFuncs gives the functions to paint values inside a text/template, where STYLE is a
+// space separated list of attributes, colors and roles of the theme, the same used for the tags of Markup
+// (ex. "bold red on black" or "error"):
+//
+//   - paint STYLE VALUES...: paints the values (joined without separator) with the given style.
+//     ex. {{ paint "red" .Name }}
+//   - highlight PATTERN TEXT [STYLE]: highlights the parts of the text matching the regular expression
+//     with the given style, or the one of the "highlight" role if not given. ex. {{ highlight "ERROR" .Line }}
+//   - markup TEXT: parses the text written in the markup language. ex. {{ markup "[bold]Name:[/] " }}
+//   - color COLOR: parses a color (see ParseColor). ex. {{ $c := color "#ff52c5" }}
+//   - contrast COLOR COLOR: gives the contrast ratio between two colors or strings (see ContrastRatio).
+//     ex. {{ if lt (contrast .Font .Background) 4.5 }}unreadable{{ end }}
+//
+// Same as Paint, everything will be shown as plain text when colors are disabled
+func (t *Theme) TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"paint": func(spec string, values ...any) (Painted, error) {
+			s, err := t.parseTag(spec)
+			if err != nil {
+				return Painted{}, err
+			}
+			return s.brush().Paint(values...), nil
+		},
+		"highlight": func(pattern string, text any, spec ...string) (Highlighted, error) {
+			find, err := regexp.Compile(pattern)
+			if err != nil {
+				return Highlighted{}, err
+			}
+
+			role := "highlight"
+			if len(spec) > 0 {
+				role = spec[0]
+			}
+			s, err := t.parseTag(role)
+			if err != nil {
+				return Highlighted{}, err
+			}
+			return s.brush().Highlight(extractContent(text), find), nil
+		},
+		"markup": t.Markup,
+		"color":  ParseColor,
+		"contrast": func(a, b any) (float64, error) {
+			first, err := templateColor(a)
+			if err != nil {
+				return 0, err
+			}
+			second, err := templateColor(b)
+			if err != nil {
+				return 0, err
+			}
+			return ContrastRatio(first, second), nil
+		},
+	}
+}
+
+// templateColor converts a Color or a string in the format accepted by ParseColor
+func templateColor(value any) (Color, error) {
+	if c, ok := value.(Color); ok {
+		return c, nil
+	}
+	return ParseColor(extractContent(value))
+}
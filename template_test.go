@@ -0,0 +1,72 @@
+package brush_test
+
+import (
+	htmltemplate "html/template"
+	"os"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleTemplateFuncs() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	tmpl := template.Must(template.New("log").Funcs(brush.TemplateFuncs()).Parse(
+		`{{ paint "bold blue" .Name }}: {{ highlight "ERROR" .Line }}` + "\n",
+	))
+
+	tmpl.Execute(os.Stdout, map[string]string{
+		"Name": "server.log",
+		"Line": "12:03 ERROR connection refused",
+	})
+
+	// Output: [1;34mserver.log[0m: 12:03 [30;43mERROR[0m connection refused
+}
+
+/* ---[ TESTS ]--- */
+
+func TestTemplateFuncs(t *testing.T) {
+	brush.DisableIfNotTTY = false
+
+	tests := []struct{ tmpl, expected string }{
+		{`{{ paint "red" "a" 1 }}`, "\x1b[31ma1\x1b[0m"},
+		{`{{ paint "red link=https://example.com" "a" }}`, "\x1b]8;;https://example.com\x1b\\\x1b[31ma\x1b[0m\x1b]8;;\x1b\\"},
+		{`{{ paint "error" . }}`, "\x1b[1;31mdata\x1b[0m"},
+		{`{{ highlight "a+" "baaad" "green on black" }}`, "b\x1b[32;40maaa\x1b[0md"},
+		{`{{ markup "[italic]x[/]" }}`, "\x1b[3mx\x1b[0m"},
+		{`{{ color "bright-red" }}`, "bright-red"},
+		{`{{ printf "%.0f" (contrast "black" (color "#ffffff")) }}`, "21"},
+	}
+
+	for _, test := range tests {
+		var (
+			out  strings.Builder
+			tmpl = template.Must(template.New("").Funcs(brush.TemplateFuncs()).Parse(test.tmpl))
+		)
+		if err := tmpl.Execute(&out, "data"); err != nil {
+			t.Errorf("Executing %s: unexpected error %v", test.tmpl, err)
+			continue
+		}
+		assert(t, "Executing "+test.tmpl, out.String(), test.expected)
+	}
+
+	for _, invalid := range []string{`{{ paint "orange" "x" }}`, `{{ highlight "(" "x" }}`, `{{ contrast "red" "nope" }}`} {
+		tmpl := template.Must(template.New("").Funcs(brush.TemplateFuncs()).Parse(invalid))
+		if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+			t.Errorf("Executing %s: expected an error", invalid)
+		}
+	}
+
+	var out strings.Builder
+	html := htmltemplate.Must(htmltemplate.New("").Funcs(htmltemplate.FuncMap(brush.TemplateFuncs())).Parse(
+		`<b>{{ paint "red" . }}</b>`,
+	))
+	if err := html.Execute(&out, "<x>"); err != nil {
+		t.Fatal(err)
+	}
+	assert(t, "Executing html template", out.String(), "<b>\x1b[31m&lt;x&gt;\x1b[0m</b>")
+}
@@ -0,0 +1,238 @@
+package brush
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	osc = string(esc) + "]"
+	st  = string(esc) + "\\"
+	bel = "\a"
+
+	// deviceAttributes is answered by (almost) every terminal, it's sent after the queries
+	// so that its response tells that the terminal does not support them without waiting for the timeout
+	deviceAttributes = csi + "c"
+)
+
+// ErrUnsupported is given when the terminal does not answer to a query
+var ErrUnsupported = errors.New("terminal does not support the query")
+
+// QueryBackground asks the background color to the terminal using the OSC 11 sequence,
+// the request is written on out and the response is read from in (that should be in raw mode).
+// If there is no answer before the timeout expires an error is given back: if in supports read deadlines
+// (like an *os.File of a pipe or a terminal) the reading stops, otherwise it goes on in background.
+// Use DetectBackground to query the terminal that is running the application
+func QueryBackground(in io.Reader, out io.Writer, timeout time.Duration) (TrueColor, error) {
+	return queryColor(in, out, timeout, "11")
+}
+
+// QueryForeground is like QueryBackground but asks for the default font color using the OSC 10 sequence
+func QueryForeground(in io.Reader, out io.Writer, timeout time.Duration) (TrueColor, error) {
+	return queryColor(in, out, timeout, "10")
+}
+
+func queryColor(in io.Reader, out io.Writer, timeout time.Duration, code string) (TrueColor, error) {
+	replies, err := query(in, out, timeout, osc+code+";?"+st)
+	if err != nil {
+		return TrueColor{}, err
+	}
+
+	for _, reply := range replies {
+		if c, found := strings.CutPrefix(reply, code+";"); found {
+			return parseOSCColor(c)
+		}
+	}
+	return TrueColor{}, ErrUnsupported
+}
+
+// query writes the request on out and reads from in the answers to the OSC sequences,
+// they are given back without the prefix and the terminator
+func query(in io.Reader, out io.Writer, timeout time.Duration, request string) ([]string, error) {
+	if _, err := io.WriteString(out, request+deviceAttributes); err != nil {
+		return nil, err
+	}
+
+	// when in supports deadlines (like the terminal used by DetectBackground) the reading is stopped
+	// once the timeout expires, otherwise it goes on in background until the next answer or the end of in
+	if d, ok := in.(interface{ SetReadDeadline(time.Time) error }); ok && d.SetReadDeadline(time.Now().Add(timeout)) == nil {
+		defer d.SetReadDeadline(time.Time{})
+
+		replies, err := readReplies(in)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil, fmt.Errorf("Cannot query the terminal: no answer after %s", timeout)
+		}
+		return replies, err
+	}
+
+	type result struct {
+		replies []string
+		err     error
+	}
+	answer := make(chan result, 1)
+
+	go func() {
+		replies, err := readReplies(in)
+		answer <- result{replies, err}
+	}()
+
+	select {
+	case res := <-answer:
+		return res.replies, res.err
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("Cannot query the terminal: no answer after %s", timeout)
+	}
+}
+
+// readReplies reads from in the answers to OSC sequences until the one to the device attributes request,
+// that is always the last because it's the last request sent
+func readReplies(in io.Reader) (replies []string, err error) {
+	var (
+		buf  []byte
+		char = make([]byte, 1)
+	)
+
+	for {
+		if _, err = in.Read(char); err != nil {
+			return nil, err
+		}
+		buf = append(buf, char[0])
+		s := string(buf)
+
+		if start := strings.LastIndex(s, csi+"?"); start >= 0 && strings.HasSuffix(s, "c") {
+			if len(replies) == 0 {
+				return nil, ErrUnsupported
+			}
+			return replies, nil
+		}
+
+		start := strings.LastIndex(s, osc)
+		if start < 0 {
+			continue
+		}
+		for _, terminator := range [...]string{st, bel} {
+			if strings.HasSuffix(s, terminator) {
+				replies = append(replies, s[start+len(osc):len(s)-len(terminator)])
+				buf = buf[:0]
+				break
+			}
+		}
+	}
+}
+
+// parseOSCColor converts a color in the format used by the terminals ("rgb:RRRR/GGGG/BBBB"
+// where each component can have from 1 to 4 hexadecimal digits) to a TrueColor
+func parseOSCColor(s string) (TrueColor, error) {
+	components := strings.Split(strings.TrimPrefix(s, "rgb:"), "/")
+	if !strings.HasPrefix(s, "rgb:") || len(components) != 3 {
+		return TrueColor{}, fmt.Errorf("Cannot parse %s color: Unknown format", s)
+	}
+
+	var nums [3]uint8
+	for i, component := range components {
+		if len(component) < 1 || len(component) > 4 {
+			return TrueColor{}, fmt.Errorf("Cannot parse %s color: Invalid component %q", s, component)
+		}
+		n, err := strconv.ParseUint(component, 16, 16)
+		if err != nil {
+			return TrueColor{}, fmt.Errorf("Cannot parse %s color: %w", s, err)
+		}
+		// scale from the range of the component to 0-255
+		maxValue := uint64(1)<<(4*len(component)) - 1
+		nums[i] = uint8((n*255 + maxValue/2) / maxValue)
+	}
+
+	return TrueColor{Red: nums[0], Green: nums[1], Blue: nums[2]}, nil
+}
+
+// DetectBackground asks the background color to the terminal that is running the application
+// (putting it in raw mode for the time of the query) and, if successful, uses it as Backdrop.
+// An error is given if stdin or stdout are not a terminal or if there is no answer before the timeout expires.
+// On Unix systems the reading stops when the timeout expires, elsewhere it goes on in background
+// until the terminal answers to another query, so in this last case the input typed afterward by the user might be lost
+func DetectBackground(timeout time.Duration) (TrueColor, error) {
+	var c TrueColor
+	err := withRawTerminal(func(in io.Reader) (err error) {
+		c, err = QueryBackground(in, os.Stdout, timeout)
+		return
+	})
+	if err == nil {
+		Backdrop = c
+	}
+
+	return c, err
+}
+
+// DetectForeground is like DetectBackground but asks for the default font color
+func DetectForeground(timeout time.Duration) (c TrueColor, err error) {
+	err = withRawTerminal(func(in io.Reader) (err error) {
+		c, err = QueryForeground(in, os.Stdout, timeout)
+		return
+	})
+
+	return
+}
+
+// withRawTerminal runs the query putting the terminal in raw mode, the answers should be read from the given input
+func withRawTerminal(run func(in io.Reader) error) error {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) || !isATTY {
+		return errors.New("Cannot query the terminal: stdin or stdout is not a terminal")
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, state)
+
+	in, release, err := stdinWithDeadline()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return run(in)
+}
+
+// HasDarkBackground tells if the Backdrop is dark, meaning that light colors are more readable on it
+func HasDarkBackground() bool {
+	return contrast(Backdrop, TrueColor{255, 255, 255}) > contrast(Backdrop, TrueColor{0, 0, 0})
+}
+
+type adaptive struct {
+	light, dark Color
+}
+
+func (a adaptive) pick() Color {
+	if HasDarkBackground() {
+		return a.dark
+	}
+	return a.light
+}
+
+func (a adaptive) ToTrueColor() TrueColor {
+	return a.pick().ToTrueColor()
+}
+
+func (a adaptive) foreground() string {
+	return a.pick().foreground()
+}
+
+func (a adaptive) background() string {
+	return a.pick().background()
+}
+
+// Adaptive creates a Color that resolves to one of the given colors depending on the terminal background:
+// light is used if the Backdrop is light, dark if it's dark (see HasDarkBackground).
+// The choice is made every time something is painted, so use DetectBackground or set the Backdrop before
+func Adaptive[color ColorType](light, dark color) Color {
+	return Color{value: adaptive{ToColor(light), ToColor(dark)}}
+}
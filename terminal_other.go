@@ -0,0 +1,10 @@
+//go:build !unix
+
+package brush
+
+import "os"
+
+// stdinWithDeadline gives stdin as it is: read deadlines are not supported on this system
+func stdinWithDeadline() (in *os.File, release func(), err error) {
+	return os.Stdin, func() {}, nil
+}
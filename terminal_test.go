@@ -0,0 +1,139 @@
+package brush_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleQueryBackground() {
+	// a fake terminal, a real application would use DetectBackground
+	var (
+		request strings.Builder
+		answer  = strings.NewReader("\x1b]11;rgb:ffff/ffff/dddd\x1b\\\x1b[?62;22c")
+	)
+
+	bg, err := brush.QueryBackground(answer, &request, time.Second)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%q\n", request.String())
+	fmt.Println(bg)
+
+	// Output:
+	// "\x1b]11;?\x1b\\\x1b[c"
+	// {255 255 221}
+}
+
+func ExampleAdaptive() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+	defer func(previous brush.TrueColor) { brush.Backdrop = previous }(brush.Backdrop)
+
+	text := brush.New(brush.Adaptive(brush.Black, brush.BrightWhite), nil)
+
+	brush.Backdrop = brush.TrueColor{Red: 250, Green: 250, Blue: 250}
+	fmt.Printf("%q\n", text.Paint("light").String())
+
+	brush.Backdrop = brush.TrueColor{Red: 30, Green: 30, Blue: 30}
+	fmt.Printf("%q\n", text.Paint("dark").String())
+
+	// Output:
+	// "\x1b[30mlight\x1b[0m"
+	// "\x1b[97mdark\x1b[0m"
+}
+
+/* ---[ TESTS ]--- */
+
+func TestQueryColor(t *testing.T) {
+	var cases = []struct {
+		answer string
+		query  func(io.Reader, io.Writer, time.Duration) (brush.TrueColor, error)
+		color  brush.TrueColor
+	}{
+		{"\x1b]11;rgb:0000/0000/0000\a", brush.QueryBackground, brush.TrueColor{}},
+		{"\x1b]11;rgb:ff/80/00\x1b\\", brush.QueryBackground, brush.TrueColor{Red: 255, Green: 128}},
+		{"\x1b]10;rgb:f/8/0\x1b\\", brush.QueryForeground, brush.TrueColor{Red: 255, Green: 136}},
+		{"typed\x1b]10;rgb:1e1e/1e1e/1e1e\a", brush.QueryForeground, brush.TrueColor{Red: 30, Green: 30, Blue: 30}},
+	}
+
+	for _, c := range cases {
+		got, err := c.query(strings.NewReader(c.answer+"\x1b[?62c"), io.Discard, time.Second)
+		if err != nil {
+			t.Errorf("answer %q: unexpected error: %s", c.answer, err)
+		} else if got != c.color {
+			t.Errorf("answer %q: expected %v, got %v", c.answer, c.color, got)
+		}
+	}
+}
+
+func TestQueryColor_fail(t *testing.T) {
+	_, err := brush.QueryBackground(strings.NewReader("\x1b[?62;22c"), io.Discard, time.Second)
+	if !errors.Is(err, brush.ErrUnsupported) {
+		t.Errorf("expected unsupported error, got: %v", err)
+	}
+
+	if _, err = brush.QueryBackground(strings.NewReader("\x1b]11;#ffffff\a\x1b[?62c"), io.Discard, time.Second); err == nil {
+		t.Error("expected error parsing invalid color format")
+	}
+
+	r, w := io.Pipe()
+	defer w.Close()
+	if _, err = brush.QueryBackground(r, io.Discard, 10*time.Millisecond); err == nil {
+		t.Error("expected timeout error for a terminal that does not answer")
+	}
+}
+
+func TestQueryColor_deadline(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	if _, err = brush.QueryBackground(r, io.Discard, 10*time.Millisecond); err == nil {
+		t.Fatal("expected timeout error for a terminal that does not answer")
+	}
+
+	// the input that comes after the timeout is not consumed by the query
+	w.WriteString("typed")
+	typed := make([]byte, 5)
+	if _, err = io.ReadFull(r, typed); err != nil || string(typed) != "typed" {
+		t.Errorf("expected the input after the timeout to be readable, got %q (%v)", typed, err)
+	}
+}
+
+func TestParseColor_adaptive(t *testing.T) {
+	defer func(previous brush.TrueColor) { brush.Backdrop = previous }(brush.Backdrop)
+
+	c, err := brush.ParseColor("black | #ffffff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := c.String(); s != "black|#ffffff" {
+		t.Errorf("unexpected string representation: %s", s)
+	}
+
+	brush.Backdrop = brush.TrueColor{Red: 255, Green: 255, Blue: 255}
+	if brush.HasDarkBackground() || c.ToTrueColor() != (brush.TrueColor{}) {
+		t.Error("expected light variant on light background")
+	}
+
+	brush.Backdrop = brush.TrueColor{}
+	if !brush.HasDarkBackground() || c.ToTrueColor() != (brush.TrueColor{Red: 255, Green: 255, Blue: 255}) {
+		t.Error("expected dark variant on dark background")
+	}
+
+	if _, err = brush.ParseColor("black|nope"); err == nil {
+		t.Error("expected error on invalid variant")
+	}
+}
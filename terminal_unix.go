@@ -0,0 +1,29 @@
+//go:build unix
+
+package brush
+
+import (
+	"os"
+	"syscall"
+)
+
+// stdinWithDeadline gives a copy of stdin that supports read deadlines, so that a query that is not answered
+// stops reading the input typed by the user. The release function must be called once done to restore stdin
+func stdinWithDeadline() (in *os.File, release func(), err error) {
+	fd, err := syscall.Dup(int(os.Stdin.Fd()))
+	if err != nil {
+		return nil, nil, err
+	}
+	// the file is added to the runtime poller only if it's already non-blocking
+	if err = syscall.SetNonblock(fd, true); err != nil {
+		syscall.Close(fd)
+		return nil, nil, err
+	}
+
+	in = os.NewFile(uintptr(fd), "/dev/stdin")
+	return in, func() {
+		in.Close()
+		// the copy shares the blocking mode with stdin
+		syscall.SetNonblock(int(os.Stdin.Fd()), false)
+	}, nil
+}
@@ -0,0 +1,162 @@
+package brush
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// Role represents the style of a semantic role (like "error" or "success") of a Theme
+type Role struct {
+	Foreground Color           `json:"foreground"`
+	Background Optional[Color] `json:"background,omitempty"`
+	Attributes Attribute       `json:"attributes,omitempty"`
+}
+
+// Brush creates a new Brush using the style of the role as default
+func (r Role) Brush() Brush[Color] {
+	b := New(r.Foreground, r.Background)
+	b.Attributes = r.Attributes
+	return b
+}
+
+// Theme maps the name of some semantic roles to their style.
+// A theme can extend a base one, inheriting all the roles that it does not override
+type Theme struct {
+	roles map[string]Role
+	base  *Theme
+}
+
+// DefaultTheme is the theme used when none is specified, you can override its roles or extend it
+var DefaultTheme = NewTheme(nil, map[string]Role{
+	"error":     {Foreground: ToColor(Red), Attributes: Bold},
+	"warning":   {Foreground: ToColor(Yellow)},
+	"success":   {Foreground: ToColor(Green)},
+	"info":      {Foreground: ToColor(Cyan)},
+	"muted":     {Foreground: ToColor(BrightBlack)},
+	"accent":    {Foreground: ToColor(Magenta)},
+	"highlight": {Foreground: ToColor(Black), Background: UseColor(ToColor(Yellow))},
+})
+
+// NewTheme creates a new Theme with the given roles that extends the base one (if not nil)
+func NewTheme(base *Theme, roles map[string]Role) *Theme {
+	t := &Theme{roles: make(map[string]Role, len(roles)), base: base}
+	for name, r := range roles {
+		t.roles[name] = r
+	}
+
+	return t
+}
+
+// Extend creates a new Theme with the given roles that extends the current one
+func (t *Theme) Extend(roles map[string]Role) *Theme {
+	return NewTheme(t, roles)
+}
+
+// Base gives the theme that the current one extends, nil if none
+func (t *Theme) Base() *Theme {
+	return t.base
+}
+
+// Set overrides the style of a role and gives back the same (now modified) theme
+func (t *Theme) Set(name string, r Role) *Theme {
+	if t.roles == nil {
+		t.roles = make(map[string]Role)
+	}
+	t.roles[name] = r
+	return t
+}
+
+// Role gives the style of the role with the given name by looking for it
+// on the current theme first and then on the ones it extends
+func (t *Theme) Role(name string) (r Role, ok bool) {
+	for ; t != nil; t = t.base {
+		if r, ok = t.roles[name]; ok {
+			return
+		}
+	}
+
+	return
+}
+
+// Brush creates a new Brush using the style of the role with the given name as default.
+// If there is no such role then the brush will use the default colors of the terminal
+func (t *Theme) Brush(name string) Brush[Color] {
+	r, _ := t.Role(name)
+	return r.Brush()
+}
+
+// Names gives the sorted names of all the roles, including the inherited ones
+func (t *Theme) Names() []string {
+	all := t.Flatten().roles
+
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Flatten creates a new Theme that does not extend any other,
+// containing all the roles of the current one, including the inherited ones
+func (t *Theme) Flatten() *Theme {
+	var themes []*Theme
+	for ; t != nil; t = t.base {
+		themes = append(themes, t)
+	}
+
+	flat := NewTheme(nil, nil)
+	for i := len(themes) - 1; i >= 0; i-- {
+		for name, r := range themes[i].roles {
+			flat.roles[name] = r
+		}
+	}
+
+	return flat
+}
+
+// MarshalJSON implements the json.Marshaler interface, only the roles that are not inherited
+// will be encoded as an object that maps their names to their style
+func (t *Theme) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.roles)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface,
+// decoded roles are added to the theme overriding the ones with the same name
+func (t *Theme) UnmarshalJSON(data []byte) error {
+	var roles map[string]Role
+	if err := json.Unmarshal(data, &roles); err != nil {
+		return err
+	}
+
+	for name, r := range roles {
+		t.Set(name, r)
+	}
+	return nil
+}
+
+// LoadTheme reads a JSON encoded Theme that extends the base one (if not nil), for example:
+//
+//	{
+//		"error": { "foreground": "bright-red", "attributes": "bold underline" },
+//		"muted": { "foreground": "#808080", "background": "236" }
+//	}
+//
+// Colors use the same format of ParseColor and attributes the one of ParseAttributes
+func LoadTheme(r io.Reader, base *Theme) (*Theme, error) {
+	t := NewTheme(base, nil)
+	if err := json.NewDecoder(r).Decode(t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Save writes the theme JSON encoded, use Flatten before saving to include also the inherited roles
+func (t *Theme) Save(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(t)
+}
@@ -0,0 +1,125 @@
+package brush_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleTheme_Brush() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	theme := brush.DefaultTheme.Extend(map[string]brush.Role{
+		"warning": {Foreground: brush.MustParseColor("208"), Attributes: brush.Underline},
+	})
+
+	theme.Brush("error").Println("something went wrong")
+	theme.Brush("warning").Println("something might go wrong")
+	theme.Brush("unknown").Println("nothing to see here")
+
+	// Output:
+	// [1;31msomething went wrong
+	// [0m[4;38;5;208msomething might go wrong
+	// [0mnothing to see here
+}
+
+func ExampleLoadTheme() {
+	config := `{
+		"error": { "foreground": "bright-red", "attributes": "bold underline" },
+		"muted": { "foreground": "#808080", "background": "236" }
+	}`
+
+	theme, err := brush.LoadTheme(strings.NewReader(config), brush.DefaultTheme)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(theme.Names())
+	theme.Save(os.Stdout)
+
+	// Output:
+	// [accent error highlight info muted success warning]
+	// {
+	// 	"error": {
+	// 		"foreground": "bright-red",
+	// 		"attributes": "bold underline"
+	// 	},
+	// 	"muted": {
+	// 		"foreground": "#808080",
+	// 		"background": "236"
+	// 	}
+	// }
+}
+
+/* ---[ TESTS ]--- */
+
+func TestTheme_Role(t *testing.T) {
+	var (
+		base  = brush.NewTheme(nil, map[string]brush.Role{"a": {Attributes: brush.Bold}, "b": {Attributes: brush.Italic}})
+		child = base.Extend(map[string]brush.Role{"b": {Attributes: brush.Underline}})
+	)
+	child.Set("c", brush.Role{Attributes: brush.Blink})
+
+	for name, expected := range map[string]brush.Attribute{"a": brush.Bold, "b": brush.Underline, "c": brush.Blink} {
+		r, ok := child.Role(name)
+		if !ok || r.Attributes != expected {
+			t.Errorf("Role(%q): got %v (found: %v), want %v", name, r.Attributes, ok, expected)
+		}
+	}
+
+	if r, _ := base.Role("b"); r.Attributes != brush.Italic {
+		t.Error("Extending a theme modified the base one")
+	}
+	if _, ok := base.Role("c"); ok {
+		t.Error("Setting a role on a theme modified the base one")
+	}
+	if child.Base() != base || child.Flatten().Base() != nil {
+		t.Error("Unexpected base theme")
+	}
+
+	var empty brush.Theme
+	if _, ok := empty.Set("x", brush.Role{}).Role("x"); !ok {
+		t.Error("Cannot set a role on the zero value of Theme")
+	}
+}
+
+func TestTheme_Save(t *testing.T) {
+	theme := brush.DefaultTheme.Extend(map[string]brush.Role{
+		"title": {
+			Foreground: brush.ToColor(brush.TrueColor{255, 82, 197}),
+			Background: brush.UseColor(brush.ToColor(brush.AlphaColor{0, 0, 0, 128})),
+			Attributes: brush.Bold | brush.Italic,
+		},
+	}).Flatten()
+
+	var buf bytes.Buffer
+	if err := theme.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := brush.LoadTheme(&buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert(t, "Loaded roles", fmt.Sprint(loaded.Names()), fmt.Sprint(theme.Names()))
+	for _, name := range theme.Names() {
+		want, _ := theme.Role(name)
+		got, _ := loaded.Role(name)
+		assert(t, "Loaded "+name, fmt.Sprint(got.Brush().Paint(name)), fmt.Sprint(want.Brush().Paint(name)))
+	}
+
+	if _, err := brush.LoadTheme(strings.NewReader(`{"x": {"foreground": "orange"}}`), nil); err == nil {
+		t.Error("Loading an invalid color should fail")
+	}
+	if _, err := brush.LoadTheme(strings.NewReader(`{"x": {"attributes": "shiny"}}`), nil); err == nil {
+		t.Error("Loading an invalid attribute should fail")
+	}
+}
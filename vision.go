@@ -0,0 +1,157 @@
+package brush
+
+import "math"
+
+// Deficiency represents a type of color vision deficiency (color blindness)
+type Deficiency uint8
+
+const (
+	Protanopia   Deficiency = iota // Protanopia is the lack of red cones
+	Deuteranopia                   // Deuteranopia is the lack of green cones
+	Tritanopia                     // Tritanopia is the lack of blue cones
+)
+
+// simulation matrices from "A Physiologically-based Model for Simulation of Color Vision Deficiency"
+// (Machado, Oliveira and Fernandes, 2009) with maximum severity, they apply on linear RGB
+var deficiencies = map[Deficiency][3][3]float64{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// String gives the name of the deficiency
+func (d Deficiency) String() string {
+	switch d {
+	case Protanopia:
+		return "protanopia"
+	case Deuteranopia:
+		return "deuteranopia"
+	case Tritanopia:
+		return "tritanopia"
+	}
+	return "unknown deficiency"
+}
+
+// Simulate gives back the color as it would be seen by a person with the given deficiency
+func (c TrueColor) Simulate(d Deficiency) TrueColor {
+	m, ok := deficiencies[d]
+	if !ok {
+		return c
+	}
+
+	r, g, b := c.linear()
+	return TrueColor{
+		Red:   delinearize(m[0][0]*r + m[0][1]*g + m[0][2]*b),
+		Green: delinearize(m[1][0]*r + m[1][1]*g + m[1][2]*b),
+		Blue:  delinearize(m[2][0]*r + m[2][1]*g + m[2][2]*b),
+	}
+}
+
+// Difference gives the perceived difference between two colors (CIE76 ΔE).
+// Values around 2 are barely noticeable, see MinDifference to tell apart colors at a glance
+func (c TrueColor) Difference(other TrueColor) float64 {
+	l1, a1, b1 := c.lab()
+	l2, a2, b2 := other.lab()
+
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2))
+}
+
+// lab converts the color to the CIELAB color space using the D65 white point
+func (c TrueColor) lab() (l, a, b float64) {
+	r, g, bl := c.linear()
+
+	var (
+		x = (0.4124*r + 0.3576*g + 0.1805*bl) / 0.95047
+		y = 0.2126*r + 0.7152*g + 0.0722*bl
+		z = (0.0193*r + 0.1192*g + 0.9505*bl) / 1.08883
+	)
+
+	f := func(t float64) float64 {
+		if t > 216.0/24389 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27*t + 16) / 116
+	}
+
+	x, y, z = f(x), f(y), f(z)
+	return 116*y - 16, 500 * (x - y), 200 * (y - z)
+}
+
+// MinDifference is the minimum Difference for two colors to be easily distinguishable
+const MinDifference float64 = 10
+
+// Confusable reports the pairs (as indexes) of the given brushes that can be told apart
+// but become indistinguishable for a person with the given deficiency.
+// Two brushes are indistinguishable when the Difference is lower than MinDifference
+// for both their font and background colors. A transparent background is considered as the Backdrop
+func Confusable[color ColorType](d Deficiency, brushes ...Brush[color]) (pairs [][2]int) {
+	type colors struct{ font, bg TrueColor }
+
+	var seen, simulated = make([]colors, len(brushes)), make([]colors, len(brushes))
+	for i, b := range brushes {
+		seen[i] = colors{b.Foreground.ToTrueColor(), Backdrop}
+		if b.Background != nil {
+			seen[i].bg = (*b.Background).ToTrueColor()
+		}
+		simulated[i] = colors{seen[i].font.Simulate(d), seen[i].bg.Simulate(d)}
+	}
+
+	similar := func(a, b colors) bool {
+		return a.font.Difference(b.font) < MinDifference && a.bg.Difference(b.bg) < MinDifference
+	}
+
+	for i := range brushes {
+		for j := i + 1; j < len(brushes); j++ {
+			if !similar(seen[i], seen[j]) && similar(simulated[i], simulated[j]) {
+				pairs = append(pairs, [2]int{i, j})
+			}
+		}
+	}
+
+	return
+}
+
+func (s style) simulate(d Deficiency) style {
+	if c, ok := decodeColor(s.foreground); ok {
+		s.foreground = c.ToTrueColor().Simulate(d).foreground()
+	}
+	if c, ok := decodeColor(s.background); ok {
+		s.background = c.ToTrueColor().Simulate(d).background()
+	}
+	return s
+}
+
+// Simulate gives back the painted item as it would be seen by a person with the given deficiency.
+// The resulting item will use TrueColor
+func (p Painted) Simulate(d Deficiency) Painted {
+	p.style = p.style.simulate(d)
+	return p
+}
+
+// Simulate gives back the highlighted item as it would be seen by a person with the given deficiency.
+// The resulting item will use TrueColor
+func (h Highlighted) Simulate(d Deficiency) Highlighted {
+	sectors := make([]section, len(h.sectors))
+	for i, sec := range h.sectors {
+		if sec.style != nil {
+			simulated := sec.style.simulate(d)
+			sec.style = &simulated
+		}
+		sectors[i] = sec
+	}
+	h.sectors = sectors
+
+	return h
+}
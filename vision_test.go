@@ -0,0 +1,103 @@
+package brush_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DazFather/brush"
+)
+
+/* ---[ EXAMPLES ]--- */
+
+func ExampleTrueColor_Simulate() {
+	red := brush.TrueColor{Red: 255, Green: 0, Blue: 0}
+
+	for _, d := range []brush.Deficiency{brush.Protanopia, brush.Deuteranopia, brush.Tritanopia} {
+		fmt.Println(d, red.Simulate(d))
+	}
+
+	// Output:
+	// protanopia {109 95 0}
+	// deuteranopia {163 144 0}
+	// tritanopia {255 0 15}
+}
+
+func ExampleConfusable() {
+	var (
+		failure = brush.New(brush.TrueColor{Red: 200, Green: 60, Blue: 40}, nil)
+		success = brush.New(brush.TrueColor{Red: 120, Green: 140, Blue: 40}, nil)
+		info    = brush.New(brush.TrueColor{Red: 40, Green: 80, Blue: 200}, nil)
+	)
+
+	fmt.Println(brush.Confusable(brush.Deuteranopia, failure, success, info))
+
+	// Output: [[0 1]]
+}
+
+func ExampleHighlighted_Simulate() {
+	brush.DisableIfNotTTY = false // probably you don't want to override this variable
+
+	var (
+		marker = brush.New(brush.BrightRed.ToTrueColor(), nil)
+		text   = marker.Highlight("something is wrong", regexp.MustCompile("wrong"))
+	)
+
+	fmt.Println(text.Simulate(brush.Protanopia))
+
+	// Output: something is [38;2;109;95;0mwrong[0m
+}
+
+/* ---[ TESTS ]--- */
+
+func TestTrueColor_Difference(t *testing.T) {
+	var (
+		black = brush.TrueColor{0, 0, 0}
+		white = brush.TrueColor{255, 255, 255}
+	)
+
+	assert(t, "Same color", black.Difference(black), 0)
+	if d := black.Difference(white); d < 99.9 || d > 100.1 {
+		t.Error("Difference between black and white | want: 100 got:", d)
+	}
+	if black.Difference(white) != white.Difference(black) {
+		t.Error("Difference is not symmetric")
+	}
+}
+
+func TestConfusable(t *testing.T) {
+	var (
+		black   = brush.UseColor(brush.TrueColor{0, 0, 0})
+		same    = brush.New(brush.TrueColor{200, 60, 40}, black)
+		failure = brush.New(brush.TrueColor{200, 60, 40}, black)
+		success = brush.New(brush.TrueColor{120, 140, 40}, black)
+		warning = brush.New(brush.TrueColor{200, 60, 40}, brush.UseColor(brush.TrueColor{255, 255, 255}))
+	)
+
+	pairs := brush.Confusable(brush.Deuteranopia, same, failure, success, warning)
+	if fmt.Sprint(pairs) != "[[0 2] [1 2]]" {
+		t.Errorf("Confusable(%s) | want: [[0 2] [1 2]] got: %v", brush.Deuteranopia, pairs)
+	}
+
+	for _, d := range []brush.Deficiency{brush.Protanopia, brush.Tritanopia} {
+		if pairs := brush.Confusable(d, failure, success, warning); len(pairs) != 0 {
+			t.Errorf("Confusable(%s) | want: [] got: %v", d, pairs)
+		}
+	}
+}
+
+func TestHighlighted_Simulate(t *testing.T) {
+	var (
+		rgx = regexp.MustCompile(`\w+`)
+		ext = brush.New(brush.ExtendedANSIColor(196), brush.UseColor(brush.ExtendedANSIColor(21)))
+		tc  = brush.New(brush.TrueColor{255, 0, 0}, brush.UseColor(brush.TrueColor{0, 0, 255}))
+	)
+	ext.Disable, tc.Disable = false, false
+
+	for _, d := range []brush.Deficiency{brush.Protanopia, brush.Deuteranopia, brush.Tritanopia} {
+		assert(t, "Simulating "+d.String(),
+			ext.Highlight("ciao mondo", rgx).Simulate(d).String(),
+			tc.Highlight("ciao mondo", rgx).Simulate(d).String(),
+		)
+	}
+}